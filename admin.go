@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DEFAULT_AUDIT_LIMIT = 100
+	MAX_AUDIT_LIMIT     = 1000
+)
+
+var (
+	errCompanyNotFound = errors.New("company not found")
+	errCompanyExists   = errors.New("company already exists")
+)
+
+type AdminToken struct {
+	Token string `yaml:"token"`
+	Name  string `yaml:"name"`
+}
+
+type AdminConfig struct {
+	Enabled bool         `yaml:"enabled"`
+	Tokens  []AdminToken `yaml:"tokens"`
+}
+
+type adminActorKey struct{}
+
+type AdminProvider struct {
+	reloader     DataReloader
+	companyStore CompanyStore
+	config       AdminConfig
+}
+
+// authenticate only allows requests with one of the bearer tokens in the admin config, and records the name of the operator for audit records.
+func (provider AdminProvider) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		token, found := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+
+		if found && token != "" {
+			for _, adminToken := range provider.config.Tokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken.Token)) == 1 {
+					ctx := context.WithValue(request.Context(), adminActorKey{}, adminToken.Name)
+					next.ServeHTTP(writer, request.WithContext(ctx))
+					return
+				}
+			}
+		}
+
+		render.Render(writer, request, ErrorUnauthorized)
+	})
+}
+
+func getAdminActor(request *http.Request) string {
+	actor, _ := request.Context().Value(adminActorKey{}).(string)
+	return actor
+}
+
+// createCompany godoc
+// @summary     Create company
+// @description Create a company, which is matched against warps after all existing companies.
+// @description The company is rejected if it is invalid or has conflicts with other companies (see /reports/companies) that would prevent the server from starting.
+// @tags        Admin
+// @accept      json
+// @produce     json
+// @security    BearerAuth
+// @param       company body     Company true "Company"
+// @success     201     {object} Company
+// @failure     400     {object} Error
+// @failure     401     {object} Error
+// @failure     409     {object} Error
+// @router      /admin/companies [post]
+func (provider AdminProvider) createCompany(writer http.ResponseWriter, request *http.Request) {
+	company, ok := decodeCompany(writer, request)
+	if !ok {
+		return
+	}
+
+	err := provider.reloader.updateCompanies(request.Context(), getAdminActor(request), func(companies []Company) ([]Company, error) {
+		for _, existing := range companies {
+			if existing.ID == company.ID {
+				return nil, errCompanyExists
+			}
+		}
+
+		return append(companies, company), nil
+	})
+	if err != nil {
+		renderCompanyUpdateError(writer, request, err)
+		return
+	}
+
+	render.Status(request, http.StatusCreated)
+	err = render.Render(writer, request, company)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// updateCompany godoc
+// @summary     Update company
+// @description Replace an existing company, keeping its position in the order that companies are matched against warps. The ID of a company cannot be changed.
+// @description The company is rejected if it is invalid or has conflicts with other companies (see /reports/companies) that would prevent the server from starting.
+// @tags        Admin
+// @accept      json
+// @produce     json
+// @security    BearerAuth
+// @param       id      path     string  true "Company ID"
+// @param       company body     Company true "Company"
+// @success     200     {object} Company
+// @failure     400     {object} Error
+// @failure     401     {object} Error
+// @failure     404     {object} Error
+// @router      /admin/companies/{id} [put]
+func (provider AdminProvider) updateCompany(writer http.ResponseWriter, request *http.Request) {
+	id := chi.URLParam(request, "id")
+
+	company, ok := decodeCompany(writer, request)
+	if !ok {
+		return
+	}
+
+	if company.ID != "" && company.ID != id {
+		detail := "The 'id' of the company must be the same as the ID in the path."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+	company.ID = id
+
+	err := provider.reloader.updateCompanies(request.Context(), getAdminActor(request), func(companies []Company) ([]Company, error) {
+		for i := range companies {
+			if companies[i].ID == id {
+				companies[i] = company
+				return companies, nil
+			}
+		}
+
+		return nil, errCompanyNotFound
+	})
+	if err != nil {
+		renderCompanyUpdateError(writer, request, err)
+		return
+	}
+
+	err = render.Render(writer, request, company)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// deleteCompany godoc
+// @summary     Delete company
+// @description Delete a company. A company cannot be deleted while it is used by any lines.
+// @tags        Admin
+// @security    BearerAuth
+// @param       id  path     string true "Company ID"
+// @success     204
+// @failure     400 {object} Error
+// @failure     401 {object} Error
+// @failure     404 {object} Error
+// @router      /admin/companies/{id} [delete]
+func (provider AdminProvider) deleteCompany(writer http.ResponseWriter, request *http.Request) {
+	id := chi.URLParam(request, "id")
+
+	err := provider.reloader.updateCompanies(request.Context(), getAdminActor(request), func(companies []Company) ([]Company, error) {
+		for i := range companies {
+			if companies[i].ID == id {
+				return append(companies[:i], companies[i+1:]...), nil
+			}
+		}
+
+		return nil, errCompanyNotFound
+	})
+	if err != nil {
+		renderCompanyUpdateError(writer, request, err)
+		return
+	}
+
+	render.NoContent(writer, request)
+}
+
+// importCompanies godoc
+// @summary     Import companies
+// @description Replace all companies with those in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml (as deployed on the server). Every company that is created, updated or deleted as a result is recorded in the audit records.
+// @tags        Admin
+// @produce     json
+// @security    BearerAuth
+// @success     200 {array}  Company
+// @failure     400 {object} Error
+// @failure     401 {object} Error
+// @router      /admin/companies/import [post]
+func (provider AdminProvider) importCompanies(writer http.ResponseWriter, request *http.Request) {
+	companies, err := readStaticData[Company](COMPANIES_PATH)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	err = provider.reloader.updateCompanies(request.Context(), getAdminActor(request), func(_ []Company) ([]Company, error) {
+		return companies, nil
+	})
+	if err != nil {
+		renderCompanyUpdateError(writer, request, err)
+		return
+	}
+
+	err = render.RenderList(writer, request, toRenderList(companies))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getCompanyAudit godoc
+// @summary     List company audit records
+// @description List the changes made to companies, most recent first, including who made each change and the company before and after it.
+// @tags        Admin
+// @produce     json
+// @security    BearerAuth
+// @param       company query    string false "Filter by company ID."
+// @param       limit   query    int    false "Limit number of records returned. Default is 100, maximum is 1000."
+// @success     200     {array}  CompanyAuditRecord
+// @failure     400     {object} Error
+// @failure     401     {object} Error
+// @router      /admin/companies/audit [get]
+func (provider AdminProvider) getCompanyAudit(writer http.ResponseWriter, request *http.Request) {
+	companyID := request.URL.Query().Get("company")
+	limitStr := request.URL.Query().Get("limit")
+
+	limit := DEFAULT_AUDIT_LIMIT
+
+	if limitStr != "" {
+		newLimit, err := strconv.Atoi(limitStr)
+		if err != nil || newLimit < 1 || newLimit > MAX_AUDIT_LIMIT {
+			detail := fmt.Sprintf("The 'limit' query parameter must be an integer within the following range: 1 <= limit <= %d.", MAX_AUDIT_LIMIT)
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		limit = newLimit
+	}
+
+	records, err := provider.companyStore.listAuditRecords(request.Context(), companyID, limit)
+	checkForErrors(err)
+
+	err = render.RenderList(writer, request, toRenderList(records))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+func decodeCompany(writer http.ResponseWriter, request *http.Request) (Company, bool) {
+	company := Company{}
+
+	err := render.DecodeJSON(request.Body, &company)
+	if err != nil {
+		detail := "The request body must be a company as a JSON object."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return Company{}, false
+	}
+
+	return company, true
+}
+
+func renderCompanyUpdateError(writer http.ResponseWriter, request *http.Request, err error) {
+	var validationError DataValidationError
+
+	switch {
+	case errors.Is(err, errCompanyNotFound):
+		render.Render(writer, request, ErrorNotFound)
+	case errors.Is(err, errCompanyExists):
+		render.Render(writer, request, ErrorConflict("A company with this ID already exists."))
+	case errors.As(err, &validationError):
+		render.Render(writer, request, ErrorBadRequest(validationError.Detail))
+	default:
+		checkForErrors(err)
+	}
+}
+
+func adminRouter(provider AdminProvider) http.Handler {
+	router := chi.NewRouter()
+	router.Use(provider.authenticate)
+
+	router.Route("/companies", func(subrouter chi.Router) {
+		subrouter.Post("/", provider.createCompany)
+		subrouter.Post("/import", provider.importCompanies)
+		subrouter.Get("/audit", provider.getCompanyAudit)
+		subrouter.Put("/{id}", provider.updateCompany)
+		subrouter.Delete("/{id}", provider.deleteCompany)
+	})
+
+	return router
+}
+
+func loadAdminConfig() AdminConfig {
+	config := AdminConfig{}
+
+	data, err := os.ReadFile(ADMIN_CONFIG_PATH)
+	checkForErrors(err)
+
+	err = yaml.Unmarshal([]byte(data), &config)
+	checkForErrors(err)
+
+	for _, token := range config.Tokens {
+		if token.Token == "" || token.Name == "" {
+			panic("Every token in the admin config must have a token and a name")
+		}
+	}
+
+	return config
+}
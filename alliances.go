@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+)
+
+type Alliance struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// Optional metadata
+	Description string `json:"description,omitempty"`
+	Website     string `json:"website,omitempty"`
+}
+
+func (alliance Alliance) GetID() string {
+	return alliance.ID
+}
+
+func (alliance Alliance) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type AllianceDetail struct {
+	Alliance
+
+	// Every company in the alliance, including subsidiaries of its members, in the order that companies are defined
+	Companies []Company `json:"companies"`
+
+	// Transport modes of the companies in the alliance
+	Modes []TransportMode `json:"modes"`
+}
+
+func (detail AllianceDetail) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type AllianceProvider struct {
+	alliances     []Alliance
+	alliancesByID *orderedmap.OrderedMap[string, Alliance]
+
+	// Map of alliance IDs to the companies in each alliance
+	companiesByAlliance map[string][]Company
+}
+
+// getAlliances godoc
+// @summary     List all alliances
+// @description List all alliances of companies (defined in https://github.com/Frumple/mrt-api/blob/main/data/alliances.yml).
+// @tags        Alliances
+// @produce     json
+// @success     200 {array} Alliance
+// @router      /alliances [get]
+func (provider AllianceProvider) getAlliances(writer http.ResponseWriter, request *http.Request) {
+	err := render.RenderList(writer, request, toRenderList(provider.alliances))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getAllianceById godoc
+// @summary     Get alliance by ID
+// @description Get alliance by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/alliances.yml), including all of its companies and their transport modes.
+// @description Companies are members of an alliance if they list it in their 'alliances', or if they are a subsidiary of such a company.
+// @tags        Alliances
+// @produce     json
+// @param       id  path     string true "Alliance ID"
+// @success     200 {object} AllianceDetail
+// @failure     404 {object} Error
+// @router      /alliances/{id} [get]
+func (provider AllianceProvider) getAllianceById(writer http.ResponseWriter, request *http.Request) {
+	id := chi.URLParam(request, "id")
+
+	alliance, exists := provider.alliancesByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	companies := provider.companiesByAlliance[alliance.ID]
+
+	modes := []TransportMode{}
+	includedModes := map[TransportMode]bool{}
+	for _, company := range companies {
+		if !includedModes[company.Mode] {
+			modes = append(modes, company.Mode)
+			includedModes[company.Mode] = true
+		}
+	}
+
+	detail := AllianceDetail{
+		Alliance:  alliance,
+		Companies: companies,
+		Modes:     modes,
+	}
+
+	err := render.Render(writer, request, detail)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+func alliancesRouter(data *DataStore) http.Handler {
+	router := chi.NewRouter()
+	router.Get("/", data.allianceHandler(AllianceProvider.getAlliances))
+
+	router.Route("/{id}", func(subrouter chi.Router) {
+		subrouter.Get("/", data.allianceHandler(AllianceProvider.getAllianceById))
+	})
+
+	return router
+}
+
+func readAlliances(companyProvider CompanyProvider) (AllianceProvider, error) {
+	alliances, err := readStaticData[Alliance](ALLIANCES_PATH)
+	if err != nil {
+		return AllianceProvider{}, err
+	}
+
+	return newAllianceProvider(alliances, companyProvider)
+}
+
+// newAllianceProvider checks that each alliance is valid and that companies only refer to existing alliances, and groups the companies by alliance.
+func newAllianceProvider(alliances []Alliance, companyProvider CompanyProvider) (AllianceProvider, error) {
+	for _, alliance := range alliances {
+		if alliance.ID == "" {
+			return AllianceProvider{}, fmt.Errorf("The alliance '%s' has an empty ID", alliance.Name)
+		}
+
+		if alliance.Website != "" && !isValidWebsite(alliance.Website) {
+			return AllianceProvider{}, fmt.Errorf("The alliance '%s' has an invalid website: '%s'", alliance.ID, alliance.Website)
+		}
+	}
+
+	alliancesByID := staticDataToOrderedMap(alliances)
+
+	if alliancesByID.Len() != len(alliances) {
+		return AllianceProvider{}, fmt.Errorf("The alliances in %s have duplicate IDs", ALLIANCES_PATH)
+	}
+
+	memberIDsByAlliance := map[string][]string{}
+	for _, company := range companyProvider.companies {
+		for _, allianceID := range company.Alliances {
+			if _, exists := alliancesByID.Get(allianceID); !exists {
+				return AllianceProvider{}, fmt.Errorf("The company '%s' has an invalid alliance: '%s'", company.ID, allianceID)
+			}
+
+			memberIDsByAlliance[allianceID] = append(memberIDsByAlliance[allianceID], company.ID)
+		}
+	}
+
+	// Subsidiaries of members are also part of the alliance
+	companiesByAlliance := map[string][]Company{}
+	for _, alliance := range alliances {
+		companies := []Company{}
+		for _, company := range companyProvider.companies {
+			for _, memberID := range memberIDsByAlliance[alliance.ID] {
+				if companyProvider.isInGroup(company, memberID) {
+					companies = append(companies, company)
+					break
+				}
+			}
+		}
+
+		companiesByAlliance[alliance.ID] = companies
+	}
+
+	return AllianceProvider{
+		alliances:           alliances,
+		alliancesByID:       alliancesByID,
+		companiesByAlliance: companiesByAlliance,
+	}, nil
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+// Named region of a world, such as a city, that is bounded by a polygon on the (x, z) plane
+type Area struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// ID of the world that the area is in
+	World string `json:"world"`
+
+	// Corners of the polygon as (x, z) pairs, in order around the area
+	Polygon [][2]float64 `json:"polygon"`
+
+	// Optional metadata
+	Description string `json:"description,omitempty"`
+}
+
+func (area Area) GetID() string {
+	return area.ID
+}
+
+func (area Area) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type AreaProvider struct {
+	areas     []Area
+	areasByID *orderedmap.OrderedMap[string, Area]
+
+	// Map of world IDs to the areas in each world
+	areasByWorld map[string][]Area
+}
+
+// getAreas     godoc
+// @summary     List all areas
+// @description List all areas, such as cities (defined in https://github.com/Frumple/mrt-api/blob/main/data/areas.yml).
+// @tags        Areas
+// @produce     json
+// @param       world query    string false "Filter by world ID (from /worlds)."
+// @success     200   {array}  Area
+// @router      /areas [get]
+func (provider AreaProvider) getAreas(writer http.ResponseWriter, request *http.Request) {
+	worldID := request.URL.Query().Get("world")
+
+	areas := provider.areas
+
+	if worldID != "" {
+		areas = provider.areasByWorld[worldID]
+		if areas == nil {
+			areas = []Area{}
+		}
+	}
+
+	err := render.RenderList(writer, request, toRenderList(areas))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getAreaById  godoc
+// @summary     Get area by ID
+// @description Get area by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/areas.yml).
+// @tags        Areas
+// @produce     json
+// @param       id  path     string true "Area ID"
+// @success     200 {object} Area
+// @failure     404 {object} Error
+// @router      /areas/{id} [get]
+func (provider AreaProvider) getAreaById(writer http.ResponseWriter, request *http.Request) {
+	id := chi.URLParam(request, "id")
+
+	area, exists := provider.areasByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	err := render.Render(writer, request, area)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getAreaWarps godoc
+// @summary     List warps in area
+// @description List the warps that lie within an area, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.
+// @tags        Areas
+// @produce     json
+// @produce     application/geo+json
+// @param       id             path     string true  "Area ID"
+// @param       format         query    string false "Response format: 'json' (default) or 'geojson'."
+// @param       name           query    string false "Filter by warp name."
+// @param       player         query    string false "Filter by player UUID (can be with or without hyphens)."
+// @param       company        query    string false "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company."
+// @param       include_legacy query    bool   false "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter."
+// @param       alliance       query    string false "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance."
+// @param       mode           query    string false "Filter by transport mode ID (from /modes)."
+// @param       type           query    int    false "Filter by type (0 = private, 1 = public)."
+// @param       region         query    string false "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files."
+// @param       chunk          query    string false "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40')."
+// @param       order_by       query    string false "Order by 'name', 'creation_date', or 'visits'."
+// @param       sort_by        query    string false "Sort by 'asc' (ascending) or 'desc' (descending)."
+// @param       limit          query    int    false "Limit number of warps returned. Maximum limit is 2000."
+// @param       offset         query    int    false "Number of warps to skip before returning."
+// @param       coords         query    string false "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8)."
+// @success     200            {object} WarpResponse
+// @failure     400            {object} Error
+// @failure     404            {object} Error
+// @router      /areas/{id}/warps [get]
+func (provider WarpProviderV2) getAreaWarps(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+
+	if _, exists := provider.areaProvider().areasByID.Get(id); !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	parameters := warpQueryParametersFromRequest(request)
+	parameters.AreaID = id
+
+	provider.renderWarps(writer, request, parameters)
+}
+
+// contains returns true if the point is inside the area's polygon, using the even-odd rule.
+// Points exactly on the boundary are not inside, in line with MySQL's ST_Contains in warpCondition.
+func (area Area) contains(x float64, z float64) bool {
+	point := [2]float64{x, z}
+	for i := range area.Polygon {
+		if segmentsIntersect(point, point, area.Polygon[i], area.Polygon[(i+1)%len(area.Polygon)]) {
+			return false
+		}
+	}
+
+	inside := false
+
+	for i, j := 0, len(area.Polygon)-1; i < len(area.Polygon); j, i = i, i+1 {
+		xi, zi := area.Polygon[i][0], area.Polygon[i][1]
+		xj, zj := area.Polygon[j][0], area.Polygon[j][1]
+
+		if (zi > z) != (zj > z) && x < (xj-xi)*(z-zi)/(zj-zi)+xi {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// warpCondition returns an expression that matches the warps inside the area's polygon.
+// The polygon is checked by MySQL's ST_Contains, so warps exactly on the boundary are not matched.
+func (area Area) warpCondition(world World) BoolExpression {
+	points := []string{}
+	for _, point := range area.Polygon {
+		points = append(points, strconv.FormatFloat(point[0], 'f', -1, 64)+" "+strconv.FormatFloat(point[1], 'f', -1, 64))
+	}
+
+	// Polygons in WKT must end with their first point
+	points = append(points, points[0])
+	polygon := "POLYGON((" + strings.Join(points, ", ") + "))"
+
+	return table.World.UUID.EQ(String(world.UUID)).
+		AND(BoolExp(Func("ST_Contains", Func("ST_GeomFromText", String(polygon)), Func("POINT", table.Warp.X, table.Warp.Z))))
+}
+
+// findAreasForWarp returns the IDs of all areas that contain the warp, in the order that areas are defined.
+func (provider AreaProvider) findAreasForWarp(world World, warp Warp) []string {
+	areaIDs := []string{}
+
+	for _, area := range provider.areasByWorld[world.ID] {
+		if area.contains(warp.X, warp.Z) {
+			areaIDs = append(areaIDs, area.ID)
+		}
+	}
+
+	return areaIDs
+}
+
+func areasRouter(warpProvider WarpProviderV2) http.Handler {
+	data := warpProvider.data
+
+	router := chi.NewRouter()
+	router.Get("/", data.areaHandler(AreaProvider.getAreas))
+
+	router.Route("/{id}", func(subrouter chi.Router) {
+		subrouter.Get("/", data.areaHandler(AreaProvider.getAreaById))
+		subrouter.Get("/warps", warpProvider.getAreaWarps)
+	})
+
+	return router
+}
+
+func readAreas(worldProvider WorldProvider) (AreaProvider, error) {
+	areas, err := readStaticData[Area](AREAS_PATH)
+	if err != nil {
+		return AreaProvider{}, err
+	}
+
+	return newAreaProvider(areas, worldProvider)
+}
+
+// newAreaProvider checks that each area is in an existing world and has a valid polygon, and groups the areas by world.
+func newAreaProvider(areas []Area, worldProvider WorldProvider) (AreaProvider, error) {
+	areasByWorld := map[string][]Area{}
+
+	for _, area := range areas {
+		if area.ID == "" {
+			return AreaProvider{}, fmt.Errorf("The area '%s' has an empty ID", area.Name)
+		}
+
+		if _, exists := worldProvider.worldsByID.Get(area.World); !exists {
+			return AreaProvider{}, fmt.Errorf("The area '%s' has an invalid world: '%s'", area.ID, area.World)
+		}
+
+		err := validatePolygon(area.Polygon)
+		if err != nil {
+			return AreaProvider{}, fmt.Errorf("The area '%s' has an invalid polygon: %w", area.ID, err)
+		}
+
+		areasByWorld[area.World] = append(areasByWorld[area.World], area)
+	}
+
+	areasByID := staticDataToOrderedMap(areas)
+
+	if areasByID.Len() != len(areas) {
+		return AreaProvider{}, fmt.Errorf("The areas in %s have duplicate IDs", AREAS_PATH)
+	}
+
+	return AreaProvider{
+		areas:        areas,
+		areasByID:    areasByID,
+		areasByWorld: areasByWorld,
+	}, nil
+}
+
+// validatePolygon checks that the polygon has at least three corners and that its edges do not cross each other, since MySQL rejects such polygons.
+func validatePolygon(polygon [][2]float64) error {
+	if len(polygon) < 3 {
+		return errors.New("it must have at least 3 corners")
+	}
+
+	edgeCount := len(polygon)
+	for i := 0; i < edgeCount; i++ {
+		a1, a2 := polygon[i], polygon[(i+1)%edgeCount]
+
+		if a1 == a2 {
+			return fmt.Errorf("it has the same corner (%g, %g) twice in a row", a1[0], a1[1])
+		}
+
+		// Adjacent edges share a corner, so only compare edges that are not next to each other
+		for j := i + 2; j < edgeCount; j++ {
+			if i == 0 && j == edgeCount-1 {
+				continue
+			}
+
+			b1, b2 := polygon[j], polygon[(j+1)%edgeCount]
+			if segmentsIntersect(a1, a2, b1, b2) {
+				return fmt.Errorf("its edges cross near (%g, %g)", a2[0], a2[1])
+			}
+		}
+	}
+
+	return nil
+}
+
+// segmentsIntersect returns true if the line segment from a1 to a2 touches the line segment from b1 to b2.
+func segmentsIntersect(a1 [2]float64, a2 [2]float64, b1 [2]float64, b2 [2]float64) bool {
+	orientation := func(p [2]float64, q [2]float64, r [2]float64) float64 {
+		return (q[0]-p[0])*(r[1]-p[1]) - (q[1]-p[1])*(r[0]-p[0])
+	}
+
+	onSegment := func(p [2]float64, q [2]float64, r [2]float64) bool {
+		return r[0] >= math.Min(p[0], q[0]) && r[0] <= math.Max(p[0], q[0]) &&
+			r[1] >= math.Min(p[1], q[1]) && r[1] <= math.Max(p[1], q[1])
+	}
+
+	d1 := orientation(b1, b2, a1)
+	d2 := orientation(b1, b2, a2)
+	d3 := orientation(a1, a2, b1)
+	d4 := orientation(a1, a2, b2)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && onSegment(b1, b2, a1)) ||
+		(d2 == 0 && onSegment(b1, b2, a2)) ||
+		(d3 == 0 && onSegment(a1, a2, b1)) ||
+		(d4 == 0 && onSegment(a1, a2, b2))
+}
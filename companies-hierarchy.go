@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+)
+
+// buildCompanySubsidiaries links each company to the company it is a subsidiary of.
+// Parent companies must exist, and a company cannot be a subsidiary of itself, directly or indirectly.
+func buildCompanySubsidiaries(companies []Company, companiesByID *orderedmap.OrderedMap[string, Company]) (map[string][]string, error) {
+	subsidiariesByID := map[string][]string{}
+
+	for _, company := range companies {
+		if company.Parent == "" {
+			continue
+		}
+
+		if _, exists := companiesByID.Get(company.Parent); !exists {
+			return nil, fmt.Errorf("The company '%s' has an invalid parent: '%s'", company.ID, company.Parent)
+		}
+
+		subsidiariesByID[company.Parent] = append(subsidiariesByID[company.Parent], company.ID)
+	}
+
+	// Since each company has at most one parent, a hierarchy is circular if following parents takes more steps than there are companies
+	for _, company := range companies {
+		steps := 0
+		for id := company.Parent; id != ""; {
+			steps++
+			if steps > len(companies) {
+				return nil, fmt.Errorf("The company '%s' is a subsidiary of itself", company.ID)
+			}
+
+			parent, _ := companiesByID.Get(id)
+			id = parent.Parent
+		}
+	}
+
+	return subsidiariesByID, nil
+}
+
+// getSubsidiaries returns the direct subsidiaries of the company, in the order that companies are defined.
+func (provider CompanyProvider) getSubsidiaries(company Company) []Company {
+	subsidiaries := []Company{}
+	for _, id := range provider.subsidiariesByID[company.ID] {
+		subsidiary, _ := provider.companiesByID.Get(id)
+		subsidiaries = append(subsidiaries, subsidiary)
+	}
+
+	return subsidiaries
+}
+
+// isInGroup returns true if the company is the group's top-level company, or one of its direct or indirect subsidiaries.
+func (provider CompanyProvider) isInGroup(company Company, groupID string) bool {
+	for id := company.ID; id != ""; {
+		if id == groupID {
+			return true
+		}
+
+		parent, _ := provider.companiesByID.Get(id)
+		id = parent.Parent
+	}
+
+	return false
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+)
+
+type CompanyDetail struct {
+	Company
+
+	// Every company in the same lineage as this company (including itself), from the earliest predecessor to the latest successor
+	Lineage []Company `json:"lineage"`
+
+	// Companies that are direct subsidiaries of this company
+	Subsidiaries []Company `json:"subsidiaries"`
+}
+
+func (detail CompanyDetail) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// buildCompanyLineages links each company to its predecessor and successor, which can be set on either or both of the two companies.
+// Each company can have at most one predecessor and one successor, and lineages cannot be circular.
+func buildCompanyLineages(companies []Company, companiesByID *orderedmap.OrderedMap[string, Company]) (map[string]string, map[string]string, error) {
+	predecessorsByID := map[string]string{}
+	successorsByID := map[string]string{}
+
+	link := func(predecessorID string, successorID string) error {
+		if predecessorID == successorID {
+			return fmt.Errorf("The company '%s' cannot be its own predecessor or successor", predecessorID)
+		}
+
+		if existing, exists := successorsByID[predecessorID]; exists && existing != successorID {
+			return fmt.Errorf("The company '%s' has more than one successor: '%s' and '%s'", predecessorID, existing, successorID)
+		}
+
+		if existing, exists := predecessorsByID[successorID]; exists && existing != predecessorID {
+			return fmt.Errorf("The company '%s' has more than one predecessor: '%s' and '%s'", successorID, existing, predecessorID)
+		}
+
+		successorsByID[predecessorID] = successorID
+		predecessorsByID[successorID] = predecessorID
+		return nil
+	}
+
+	for _, company := range companies {
+		if company.Predecessor != "" {
+			if _, exists := companiesByID.Get(company.Predecessor); !exists {
+				return nil, nil, fmt.Errorf("The company '%s' has an invalid predecessor: '%s'", company.ID, company.Predecessor)
+			}
+
+			if err := link(company.Predecessor, company.ID); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if company.Successor != "" {
+			if _, exists := companiesByID.Get(company.Successor); !exists {
+				return nil, nil, fmt.Errorf("The company '%s' has an invalid successor: '%s'", company.ID, company.Successor)
+			}
+
+			if err := link(company.ID, company.Successor); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	// Since each company has at most one successor, a lineage is circular if following successors takes more steps than there are companies
+	for _, company := range companies {
+		steps := 0
+		for id, exists := successorsByID[company.ID]; exists; id, exists = successorsByID[id] {
+			steps++
+			if steps > len(companies) {
+				return nil, nil, fmt.Errorf("The lineage of company '%s' is circular", company.ID)
+			}
+		}
+	}
+
+	return predecessorsByID, successorsByID, nil
+}
+
+// getLineage returns every company in the same lineage as the company (including itself), from the earliest predecessor to the latest successor.
+func (provider CompanyProvider) getLineage(company Company) []Company {
+	firstID := company.ID
+	for id, exists := provider.predecessorsByID[firstID]; exists; id, exists = provider.predecessorsByID[id] {
+		firstID = id
+	}
+
+	lineage := []Company{}
+	for id, exists := firstID, true; exists; id, exists = provider.successorsByID[id] {
+		lineageCompany, _ := provider.companiesByID.Get(id)
+		lineage = append(lineage, lineageCompany)
+	}
+
+	return lineage
+}
+
+// getLegacyCompanies returns all predecessors of the company, starting with the most recent.
+func (provider CompanyProvider) getLegacyCompanies(company Company) []Company {
+	legacyCompanies := []Company{}
+	for id, exists := provider.predecessorsByID[company.ID]; exists; id, exists = provider.predecessorsByID[id] {
+		legacyCompany, _ := provider.companiesByID.Get(id)
+		legacyCompanies = append(legacyCompanies, legacyCompany)
+	}
+
+	return legacyCompanies
+}
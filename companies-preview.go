@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/render"
+)
+
+// Number of example warps included in a company preview
+const PREVIEW_SAMPLE_SIZE = 20
+
+// Maximum time spent querying the warps of a company preview, since the pattern and regex are supplied by the caller
+const PREVIEW_TIMEOUT = 10 * time.Second
+
+type CompanyPreviewRequest struct {
+	Pattern string        `json:"pattern"`
+	Regex   string        `json:"regex,omitempty"`
+	Mode    TransportMode `json:"mode,omitempty"`
+}
+
+type CompanyPreviewOverlap struct {
+	// ID of the existing company that the warps currently belong to
+	Company  string   `json:"company"`
+	Count    int      `json:"count"`
+	Examples []string `json:"examples"`
+}
+
+type CompanyPreview struct {
+	Pattern string        `json:"pattern"`
+	Regex   string        `json:"regex,omitempty"`
+	Mode    TransportMode `json:"mode,omitempty"`
+
+	// Number of warps matched by the pattern (and regex)
+	Matches int `json:"matches"`
+
+	// Number of matched warps that do not belong to any existing company, which the company would receive if it was added after all existing companies
+	Unclaimed int `json:"unclaimed"`
+
+	// The first matched warps, ordered by name
+	Warps []Warp `json:"warps"`
+
+	// Existing companies whose warps are also matched, ordered by the number of warps matched (highest first)
+	Overlaps []CompanyPreviewOverlap `json:"overlaps"`
+}
+
+func (preview CompanyPreview) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// previewCompany godoc
+// @summary     Preview company pattern
+// @description Show which warps a proposed company would match, before it is added to https://github.com/Frumple/mrt-api/blob/main/data/companies.yml.
+// @description Warps are matched in the same way as the 'company' filter of /warps. The response includes the number of matching warps, the first matching warps, and the existing companies whose warps would also be matched.
+// @description Previews that take longer than 10 seconds are cancelled, and return a bad request instead.
+// @tags        Companies
+// @accept      json
+// @produce     json
+// @param       company body     CompanyPreviewRequest true "Proposed pattern, and optionally regex and transport mode"
+// @success     200     {object} CompanyPreview
+// @failure     400     {object} Error
+// @router      /companies/preview [post]
+func (provider WarpProviderV2) previewCompany(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	previewRequest := CompanyPreviewRequest{}
+
+	err := render.DecodeJSON(request.Body, &previewRequest)
+	if err != nil {
+		detail := "The request body must be a JSON object with a 'pattern', and optionally a 'regex' and 'mode'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	if previewRequest.Pattern == "" {
+		detail := "The 'pattern' must not be empty."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	if previewRequest.Mode != "" {
+		if _, exists := provider.modeProvider().modesByID.Get(string(previewRequest.Mode)); !exists {
+			detail := fmt.Sprintf("The 'mode' must be one of %s.", provider.modeProvider().listModeIDs())
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+	}
+
+	// Compile the proposed company in the same way as the existing companies
+	candidate := Company{
+		Pattern: previewRequest.Pattern,
+		Regex:   previewRequest.Regex,
+	}
+
+	candidate.patternRegexp, err = likePatternToRegexp(candidate.Pattern)
+	if err != nil {
+		detail := "The 'pattern' must be a valid MySQL LIKE pattern."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	if candidate.Regex != "" {
+		candidate.regexRegexp, err = compileCompanyRegex(candidate.Regex)
+		if err != nil {
+			detail := "The 'regex' is invalid: " + err.Error()
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(request.Context(), PREVIEW_TIMEOUT)
+	defer cancel()
+
+	response, err := provider.queryWarps(ctx, candidate.warpCondition(), table.Warp.Name.ASC(), PREVIEW_SAMPLE_SIZE, 0)
+	if detail, rejected := previewErrorDetail(err); rejected {
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+	checkForErrors(err)
+
+	unclaimed := 0
+	overlapsByID := map[string]*CompanyPreviewOverlap{}
+
+	err = provider.streamWarps(ctx, candidate.warpCondition(), table.Warp.Name.ASC(), func(warp Warp) error {
+		company, exists := provider.companyProvider().findCompanyForWarp(warp.Name)
+		if !exists {
+			unclaimed++
+			return nil
+		}
+
+		overlap, exists := overlapsByID[company.ID]
+		if !exists {
+			overlap = &CompanyPreviewOverlap{Company: company.ID, Examples: []string{}}
+			overlapsByID[company.ID] = overlap
+		}
+
+		overlap.Count++
+		if len(overlap.Examples) < MAX_CONFLICT_EXAMPLES {
+			overlap.Examples = append(overlap.Examples, warp.Name)
+		}
+
+		return nil
+	})
+	if detail, rejected := previewErrorDetail(err); rejected {
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+	checkForErrors(err)
+
+	overlaps := []CompanyPreviewOverlap{}
+	for _, overlap := range overlapsByID {
+		overlaps = append(overlaps, *overlap)
+	}
+
+	sort.Slice(overlaps, func(i, j int) bool {
+		if overlaps[i].Count != overlaps[j].Count {
+			return overlaps[i].Count > overlaps[j].Count
+		}
+		return overlaps[i].Company < overlaps[j].Company
+	})
+
+	preview := CompanyPreview{
+		Pattern:   candidate.Pattern,
+		Regex:     candidate.Regex,
+		Mode:      previewRequest.Mode,
+		Matches:   response.Pagination.TotalHits,
+		Unclaimed: unclaimed,
+		Warps:     response.Result,
+		Overlaps:  overlaps,
+	}
+
+	err = render.Render(writer, request, preview)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// previewErrorDetail returns the detail of a bad request if a preview query failed because of the proposed pattern or regex, rather than for any other reason.
+func previewErrorDetail(err error) (string, bool) {
+	switch {
+	case isRegexError(err):
+		return "The 'regex' is not supported by MySQL: " + err.Error(), true
+	case errors.Is(err, context.DeadlineExceeded):
+		return fmt.Sprintf("The preview took longer than %s. Use a more specific 'pattern' or 'regex'.", PREVIEW_TIMEOUT), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/model"
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+// Recorded as the actor when companies are first copied from companies.yml into the database
+const SEED_ACTOR = "seed"
+
+type AuditAction string
+
+const (
+	CreateAction AuditAction = "create"
+	UpdateAction AuditAction = "update"
+	DeleteAction AuditAction = "delete"
+)
+
+type CompanyAuditRecord struct {
+	ID        uint64      `json:"id"`
+	CompanyID string      `json:"companyID"`
+	Action    AuditAction `json:"action"`
+	Actor     string      `json:"actor"`
+	Previous  *Company    `json:"previous"`
+	Current   *Company    `json:"current"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+func (record CompanyAuditRecord) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// Stores companies in the database (see sql/mrt_companies.sql), along with an audit record of every change.
+type CompanyStore struct {
+	db *sql.DB
+}
+
+func (store CompanyStore) listCompanies(ctx context.Context) ([]Company, error) {
+	rows := []model.MrtCompany{}
+
+	statement := SELECT(table.MrtCompany.AllColumns).
+		FROM(table.MrtCompany).
+		ORDER_BY(table.MrtCompany.Position.ASC())
+
+	err := statement.QueryContext(ctx, store.db, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	companies := []Company{}
+	for _, row := range rows {
+		company := Company{}
+		err = json.Unmarshal([]byte(row.Data), &company)
+		if err != nil {
+			return nil, err
+		}
+
+		companies = append(companies, company)
+	}
+
+	return companies, nil
+}
+
+// seed copies the companies from companies.yml into the database, if there are no companies in the database yet.
+func (store CompanyStore) seed(ctx context.Context) error {
+	companies, err := store.listCompanies(ctx)
+	if err != nil || len(companies) > 0 {
+		return err
+	}
+
+	companies, err = readStaticData[Company](COMPANIES_PATH)
+	if err != nil {
+		return err
+	}
+
+	log.Println("Copying companies from ", COMPANIES_PATH, " into the database")
+	return store.saveCompanies(ctx, []Company{}, companies, SEED_ACTOR)
+}
+
+// saveCompanies replaces the previous list of companies with the new list, recording an audit record for every company that was created, updated or deleted.
+func (store CompanyStore) saveCompanies(ctx context.Context, previous []Company, companies []Company, actor string) error {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+
+	previousByID := map[string]Company{}
+	previousPositions := map[string]int{}
+	for i, company := range previous {
+		previousByID[company.ID] = company
+		previousPositions[company.ID] = i
+	}
+
+	currentIDs := map[string]bool{}
+
+	for i, company := range companies {
+		currentIDs[company.ID] = true
+
+		data, err := json.Marshal(company)
+		if err != nil {
+			return err
+		}
+
+		previousCompany, exists := previousByID[company.ID]
+
+		previousData := []byte{}
+		if exists {
+			previousData, err = json.Marshal(previousCompany)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case !exists:
+			_, err = table.MrtCompany.
+				INSERT(table.MrtCompany.AllColumns).
+				MODEL(model.MrtCompany{CompanyID: company.ID, Position: uint32(i), Data: string(data)}).
+				ExecContext(ctx, tx)
+			if err == nil {
+				err = insertCompanyAudit(ctx, tx, CreateAction, actor, nil, &company, now)
+			}
+		case string(previousData) != string(data):
+			_, err = table.MrtCompany.
+				UPDATE(table.MrtCompany.Position, table.MrtCompany.Data).
+				SET(Int(int64(i)), String(string(data))).
+				WHERE(table.MrtCompany.CompanyID.EQ(String(company.ID))).
+				ExecContext(ctx, tx)
+			if err == nil {
+				err = insertCompanyAudit(ctx, tx, UpdateAction, actor, &previousCompany, &company, now)
+			}
+		case previousPositions[company.ID] != i:
+			// Moving a company is not recorded, since it is always the result of another company being created or deleted
+			_, err = table.MrtCompany.
+				UPDATE(table.MrtCompany.Position).
+				SET(Int(int64(i))).
+				WHERE(table.MrtCompany.CompanyID.EQ(String(company.ID))).
+				ExecContext(ctx, tx)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, previousCompany := range previous {
+		if currentIDs[previousCompany.ID] {
+			continue
+		}
+
+		previousCompany := previousCompany
+
+		_, err = table.MrtCompany.
+			DELETE().
+			WHERE(table.MrtCompany.CompanyID.EQ(String(previousCompany.ID))).
+			ExecContext(ctx, tx)
+		if err == nil {
+			err = insertCompanyAudit(ctx, tx, DeleteAction, actor, &previousCompany, nil, now)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func insertCompanyAudit(ctx context.Context, tx *sql.Tx, action AuditAction, actor string, previous *Company, current *Company, createdAt time.Time) error {
+	record := model.MrtCompanyAudit{
+		Action:    string(action),
+		Actor:     actor,
+		CreatedAt: createdAt,
+	}
+
+	if current != nil {
+		record.CompanyID = current.ID
+	} else {
+		record.CompanyID = previous.ID
+	}
+
+	var err error
+
+	record.Previous, err = marshalAuditCompany(previous)
+	if err != nil {
+		return err
+	}
+
+	record.Current, err = marshalAuditCompany(current)
+	if err != nil {
+		return err
+	}
+
+	_, err = table.MrtCompanyAudit.
+		INSERT(table.MrtCompanyAudit.MutableColumns).
+		MODEL(record).
+		ExecContext(ctx, tx)
+	return err
+}
+
+func marshalAuditCompany(company *Company) (*string, error) {
+	if company == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(company)
+	if err != nil {
+		return nil, err
+	}
+
+	value := string(data)
+	return &value, nil
+}
+
+// listAuditRecords returns the most recent audit records first, optionally only those of a single company.
+func (store CompanyStore) listAuditRecords(ctx context.Context, companyID string, limit int) ([]CompanyAuditRecord, error) {
+	rows := []model.MrtCompanyAudit{}
+
+	statement := SELECT(table.MrtCompanyAudit.AllColumns).
+		FROM(table.MrtCompanyAudit)
+
+	if companyID != "" {
+		statement = statement.WHERE(table.MrtCompanyAudit.CompanyID.EQ(String(companyID)))
+	}
+
+	statement = statement.
+		ORDER_BY(table.MrtCompanyAudit.AuditID.DESC()).
+		LIMIT(int64(limit))
+
+	err := statement.QueryContext(ctx, store.db, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []CompanyAuditRecord{}
+	for _, row := range rows {
+		record := CompanyAuditRecord{
+			ID:        row.AuditID,
+			CompanyID: row.CompanyID,
+			Action:    AuditAction(row.Action),
+			Actor:     row.Actor,
+			CreatedAt: row.CreatedAt,
+		}
+
+		record.Previous, err = unmarshalAuditCompany(row.Previous)
+		if err != nil {
+			return nil, err
+		}
+
+		record.Current, err = unmarshalAuditCompany(row.Current)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func unmarshalAuditCompany(data *string) (*Company, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	company := Company{}
+	err := json.Unmarshal([]byte(*data), &company)
+	if err != nil {
+		return nil, err
+	}
+
+	return &company, nil
+}
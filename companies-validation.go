@@ -0,0 +1,475 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-sql-driver/mysql"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+// Maximum number of example warp names included in each conflict
+const MAX_CONFLICT_EXAMPLES = 5
+
+type ConflictSeverity string
+
+const (
+	// Always prevents the companies from being loaded
+	SeverityError ConflictSeverity = "error"
+	// Only prevents the companies from being loaded in strict mode
+	SeverityWarning ConflictSeverity = "warning"
+)
+
+type ConflictType string
+
+const (
+	DuplicateID    ConflictType = "duplicate_id"
+	PatternOverlap ConflictType = "pattern_overlap"
+	WarpOverlap    ConflictType = "warp_overlap"
+	NoWarps        ConflictType = "no_warps"
+	RegexMismatch  ConflictType = "regex_mismatch"
+)
+
+type CompanyConflict struct {
+	Type      ConflictType     `json:"type"`
+	Severity  ConflictSeverity `json:"severity"`
+	Companies []string         `json:"companies"`
+	Message   string           `json:"message"`
+	Examples  []string         `json:"examples"`
+}
+
+type CompanyValidationReport struct {
+	Conflicts []CompanyConflict `json:"conflicts"`
+}
+
+func (report CompanyValidationReport) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// fails returns true if any of the conflicts should prevent the companies from being loaded.
+func (report CompanyValidationReport) fails(strict bool) bool {
+	for _, conflict := range report.Conflicts {
+		if conflict.Severity == SeverityError || strict {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (report CompanyValidationReport) log() {
+	for _, conflict := range report.Conflicts {
+		if len(conflict.Examples) > 0 {
+			log.Printf("Company %s: %s (e.g. %s)", conflict.Severity, conflict.Message, strings.Join(conflict.Examples, ", "))
+		} else {
+			log.Printf("Company %s: %s", conflict.Severity, conflict.Message)
+		}
+	}
+}
+
+// validateCompanies checks the companies for conflicts with each other, and with the warps currently in the database.
+// The companies do not need to be the ones currently in use, so that new companies can be checked before they are loaded.
+func (provider WarpProviderV2) validateCompanies(ctx context.Context, companies []Company) (CompanyValidationReport, error) {
+	provider = provider.withSnapshot()
+
+	report := CompanyValidationReport{
+		Conflicts: validateCompanyPatterns(companies),
+	}
+
+	conflicts, err := provider.validateCompanyWarps(ctx, companies)
+	if err != nil {
+		return CompanyValidationReport{}, err
+	}
+
+	report.Conflicts = append(report.Conflicts, conflicts...)
+	return report, nil
+}
+
+// validateCompanyPatterns checks for companies that share an ID, and for pairs of patterns that could both match the same warp name.
+func validateCompanyPatterns(companies []Company) []CompanyConflict {
+	conflicts := []CompanyConflict{}
+
+	companiesByID := map[string]int{}
+	for _, company := range companies {
+		companiesByID[company.ID]++
+	}
+
+	for _, company := range companies {
+		count := companiesByID[company.ID]
+		if count > 1 {
+			conflicts = append(conflicts, CompanyConflict{
+				Type:      DuplicateID,
+				Severity:  SeverityError,
+				Companies: []string{company.ID},
+				Message:   fmt.Sprintf("The company ID '%s' is used by %d companies", company.ID, count),
+				Examples:  []string{},
+			})
+
+			// Only report each duplicate ID once
+			companiesByID[company.ID] = 0
+		}
+	}
+
+	acknowledgedOverlaps := newAcknowledgedOverlaps(companies)
+
+	for i := range companies {
+		for j := i + 1; j < len(companies); j++ {
+			a, b := companies[i], companies[j]
+
+			if acknowledgedOverlaps.includes([]string{a.ID, b.ID}) {
+				continue
+			}
+
+			example, overlaps := findLikePatternOverlap(a.Pattern, b.Pattern)
+			if !overlaps {
+				continue
+			}
+
+			// A regex may exclude the example even if other names overlap, so overlaps involving a regex are only reported here if the example is matched by both.
+			// Otherwise they are found by checking the warps themselves.
+			if (a.Regex != "" || b.Regex != "") && !(a.matches(example) && b.matches(example)) {
+				continue
+			}
+
+			conflicts = append(conflicts, CompanyConflict{
+				Type:      PatternOverlap,
+				Severity:  SeverityWarning,
+				Companies: []string{a.ID, b.ID},
+				Message:   fmt.Sprintf("The patterns of companies '%s' ('%s') and '%s' ('%s') can match the same warp names", a.ID, a.Pattern, b.ID, b.Pattern),
+				Examples:  []string{example},
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// Pairs of company IDs whose overlaps are acknowledged by the 'overlaps' of either company
+type AcknowledgedOverlaps map[[2]string]bool
+
+func newAcknowledgedOverlaps(companies []Company) AcknowledgedOverlaps {
+	overlaps := AcknowledgedOverlaps{}
+	for _, company := range companies {
+		for _, id := range company.Overlaps {
+			overlaps[[2]string{company.ID, id}] = true
+			overlaps[[2]string{id, company.ID}] = true
+		}
+	}
+
+	return overlaps
+}
+
+// includes returns true if the overlaps between every pair of the companies are acknowledged.
+func (overlaps AcknowledgedOverlaps) includes(ids []string) bool {
+	for i := range ids {
+		for j := i + 1; j < len(ids); j++ {
+			if !overlaps[[2]string{ids[i], ids[j]}] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// validateCompanyWarps checks for warps in the database that are matched by more than one company, for companies that match no warps at all,
+// and for companies with a regex that is evaluated differently by MySQL and Go.
+func (provider WarpProviderV2) validateCompanyWarps(ctx context.Context, companies []Company) ([]CompanyConflict, error) {
+	conflicts := []CompanyConflict{}
+
+	if len(companies) == 0 {
+		return conflicts, nil
+	}
+
+	orExpressions := []BoolExpression{}
+	for _, company := range companies {
+		orExpressions = append(orExpressions, table.Warp.Name.LIKE(String(company.Pattern)))
+	}
+
+	acknowledgedOverlaps := newAcknowledgedOverlaps(companies)
+
+	warpCounts := map[string]int{}
+	overlapKeys := []string{}
+	overlapCounts := map[string]int{}
+	overlapExamples := map[string][]string{}
+	overlapCompanies := map[string][]string{}
+
+	// Names of the warps matched in memory by each company with a regex, by warp ID
+	regexMatches := map[string]map[uint32]string{}
+	for _, company := range companies {
+		if company.Regex != "" {
+			regexMatches[company.ID] = map[uint32]string{}
+		}
+	}
+
+	// Only the LIKE patterns are used here, so that every warp that a regex could match is also checked in memory
+	err := provider.streamWarps(ctx, OR(orExpressions...), table.Warp.WarpID.ASC(), func(warp Warp) error {
+		matches := []string{}
+		for _, company := range companies {
+			if company.matches(warp.Name) {
+				matches = append(matches, company.ID)
+				warpCounts[company.ID]++
+
+				if company.Regex != "" {
+					regexMatches[company.ID][warp.ID] = warp.Name
+				}
+			}
+		}
+
+		if len(matches) < 2 || acknowledgedOverlaps.includes(matches) {
+			return nil
+		}
+
+		key := strings.Join(matches, ",")
+		if _, exists := overlapCounts[key]; !exists {
+			overlapKeys = append(overlapKeys, key)
+			overlapCompanies[key] = matches
+		}
+
+		overlapCounts[key]++
+		if len(overlapExamples[key]) < MAX_CONFLICT_EXAMPLES {
+			overlapExamples[key] = append(overlapExamples[key], warp.Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(overlapKeys)
+
+	for _, key := range overlapKeys {
+		conflicts = append(conflicts, CompanyConflict{
+			Type:      WarpOverlap,
+			Severity:  SeverityWarning,
+			Companies: overlapCompanies[key],
+			Message:   fmt.Sprintf("%d warps are matched by all of the companies '%s'", overlapCounts[key], strings.Join(overlapCompanies[key], "', '")),
+			Examples:  overlapExamples[key],
+		})
+	}
+
+	for _, company := range companies {
+		if warpCounts[company.ID] == 0 {
+			conflicts = append(conflicts, CompanyConflict{
+				Type:      NoWarps,
+				Severity:  SeverityWarning,
+				Companies: []string{company.ID},
+				Message:   fmt.Sprintf("The pattern of company '%s' ('%s') does not match any warps", company.ID, company.Pattern),
+				Examples:  []string{},
+			})
+		}
+	}
+
+	for _, company := range companies {
+		if company.Regex == "" {
+			continue
+		}
+
+		conflict, err := provider.validateCompanyRegex(ctx, company, regexMatches[company.ID])
+		if err != nil {
+			return nil, err
+		}
+
+		if conflict != nil {
+			conflicts = append(conflicts, *conflict)
+		}
+	}
+
+	return conflicts, nil
+}
+
+// validateCompanyRegex compares the warps matched by the company in the database with those matched in memory, returning a conflict if they differ.
+func (provider WarpProviderV2) validateCompanyRegex(ctx context.Context, company Company, memoryMatches map[uint32]string) (*CompanyConflict, error) {
+	mismatches := 0
+	examples := []string{}
+
+	addMismatch := func(warpName string) {
+		mismatches++
+		if len(examples) < MAX_CONFLICT_EXAMPLES {
+			examples = append(examples, warpName)
+		}
+	}
+
+	databaseMatches := map[uint32]bool{}
+	err := provider.streamWarps(ctx, company.warpCondition(), table.Warp.WarpID.ASC(), func(warp Warp) error {
+		databaseMatches[warp.ID] = true
+		if _, exists := memoryMatches[warp.ID]; !exists {
+			addMismatch(warp.Name)
+		}
+		return nil
+	})
+	if isRegexError(err) {
+		return nil, DataValidationError{fmt.Sprintf("The regex of company '%s' ('%s') is not supported by MySQL: %s", company.ID, company.Regex, err)}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []uint32{}
+	for id := range memoryMatches {
+		if !databaseMatches[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		addMismatch(memoryMatches[id])
+	}
+
+	if mismatches == 0 {
+		return nil, nil
+	}
+
+	return &CompanyConflict{
+		Type:      RegexMismatch,
+		Severity:  SeverityError,
+		Companies: []string{company.ID},
+		Message:   fmt.Sprintf("The regex of company '%s' ('%s') matches %d warps differently in MySQL and Go", company.ID, company.Regex, mismatches),
+		Examples:  examples,
+	}, nil
+}
+
+// isRegexError returns true if MySQL rejected a query because of one of its regular expressions (errors 3685 to 3700), rather than for any other reason.
+func isRegexError(err error) bool {
+	var mysqlError *mysql.MySQLError
+	return errors.As(err, &mysqlError) && mysqlError.Number >= 3685 && mysqlError.Number <= 3700
+}
+
+type likeTokenKind int
+
+const (
+	likeLiteral likeTokenKind = iota
+	likeAnyCharacter
+	likeAnySequence
+)
+
+type likeToken struct {
+	kind      likeTokenKind
+	character rune
+}
+
+func tokenizeLikePattern(pattern string) []likeToken {
+	tokens := []likeToken{}
+
+	escaped := false
+	for _, character := range pattern {
+		switch {
+		case escaped:
+			tokens = append(tokens, likeToken{likeLiteral, character})
+			escaped = false
+		case character == '\\':
+			escaped = true
+		case character == '%':
+			tokens = append(tokens, likeToken{kind: likeAnySequence})
+		case character == '_':
+			tokens = append(tokens, likeToken{kind: likeAnyCharacter})
+		default:
+			tokens = append(tokens, likeToken{likeLiteral, character})
+		}
+	}
+
+	return tokens
+}
+
+// findLikePatternOverlap determines whether any string is matched by both LIKE patterns, and returns the shortest such string as an example.
+// This performs a breadth-first search over pairs of positions in both patterns, where each step either consumes
+// a character that both patterns accept, or skips over a '%' that matches nothing.
+func findLikePatternOverlap(a string, b string) (string, bool) {
+	tokensA := tokenizeLikePattern(a)
+	tokensB := tokenizeLikePattern(b)
+
+	width := len(tokensB) + 1
+	start := 0
+	goal := len(tokensA)*width + len(tokensB)
+
+	type step struct {
+		previous  int
+		character rune
+		consumes  bool
+	}
+
+	visited := map[int]step{start: {previous: -1}}
+	queue := []int{start}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		if state == goal {
+			characters := []rune{}
+			for current := goal; current != start; current = visited[current].previous {
+				if visited[current].consumes {
+					characters = append([]rune{visited[current].character}, characters...)
+				}
+			}
+			return string(characters), true
+		}
+
+		i, j := state/width, state%width
+
+		visit := func(nextI int, nextJ int, character rune, consumes bool) {
+			next := nextI*width + nextJ
+			if _, exists := visited[next]; !exists {
+				visited[next] = step{state, character, consumes}
+				queue = append(queue, next)
+			}
+		}
+
+		// Skip over a '%' that matches nothing
+		if i < len(tokensA) && tokensA[i].kind == likeAnySequence {
+			visit(i+1, j, 0, false)
+		}
+		if j < len(tokensB) && tokensB[j].kind == likeAnySequence {
+			visit(i, j+1, 0, false)
+		}
+
+		if i == len(tokensA) || j == len(tokensB) {
+			continue
+		}
+
+		// Consume one character that is accepted by both patterns
+		tokenA, tokenB := tokensA[i], tokensB[j]
+
+		nextI, nextJ := i+1, j+1
+		if tokenA.kind == likeAnySequence {
+			nextI = i
+		}
+		if tokenB.kind == likeAnySequence {
+			nextJ = j
+		}
+
+		// Consuming a character with '%' on both sides does not change the state
+		if nextI == i && nextJ == j {
+			continue
+		}
+
+		switch {
+		case tokenA.kind == likeLiteral && tokenB.kind == likeLiteral:
+			if unicode.ToLower(tokenA.character) == unicode.ToLower(tokenB.character) {
+				visit(nextI, nextJ, tokenA.character, true)
+			}
+		case tokenA.kind == likeLiteral:
+			visit(nextI, nextJ, tokenA.character, true)
+		case tokenB.kind == likeLiteral:
+			visit(nextI, nextJ, tokenB.character, true)
+		default:
+			visit(nextI, nextJ, 'x', true)
+		}
+	}
+
+	return "", false
+}
@@ -1,43 +1,111 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	orderedmap "github.com/wk8/go-ordered-map/v2"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
 )
 
-type TransportMode string
+// Used in place of a company ID to filter for warps that do not belong to any company
+const NO_COMPANY_ID = "none"
 
-const (
-	WarpRail TransportMode = "warp_rail"
-	Bus      TransportMode = "bus"
-	Air      TransportMode = "air"
-	Sea      TransportMode = "sea"
-	Other    TransportMode = "other"
-)
+// Format of company founding dates
+const DATE_FORMAT = "2006-01-02"
 
-var transportModes = []TransportMode{
-	WarpRail,
-	Bus,
-	Air,
-	Sea,
-	Other,
-}
+// Colours of companies and lines, in the format "#RRGGBB"
+var colourRegexp = regexp.MustCompile("^#[0-9A-Fa-f]{6}$")
 
 type Company struct {
 	ID      string        `json:"id"`
 	Name    string        `json:"name"`
 	Pattern string        `json:"pattern"`
 	Mode    TransportMode `json:"mode"`
+
+	// Optional regular expression that warp names must also match, for rules that a LIKE pattern cannot express.
+	// It is evaluated with MySQL's REGEXP in the database and with Go's regexp in memory, so only syntax supported by both should be used.
+	Regex string `json:"regex,omitempty"`
+
+	// Optional metadata
+	Colour      string   `json:"colour,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Website     string   `json:"website,omitempty"`
+	Owners      []string `json:"owners,omitempty"`
+	Founded     string   `json:"founded,omitempty"`
+	// File name of the logo in data/logos, served by /companies/{id}/logo
+	Logo string `json:"logo,omitempty"`
+
+	// IDs of the companies that this company replaced, or was replaced by
+	Predecessor string `json:"predecessor,omitempty"`
+	Successor   string `json:"successor,omitempty"`
+
+	// Dates between which the company was active, in the format "YYYY-MM-DD"
+	ActiveFrom string `json:"activeFrom,omitempty" yaml:"active_from"`
+	ActiveTo   string `json:"activeTo,omitempty" yaml:"active_to"`
+
+	// ID of the company that this company is a subsidiary of
+	Parent string `json:"parent,omitempty"`
+
+	// IDs of the alliances (defined in alliances.yml) that this company is a member of
+	Alliances []string `json:"alliances,omitempty"`
+
+	// IDs of the companies whose patterns are known to match some of the same warp names as this company.
+	// Warps go to whichever company is listed first, so these overlaps are not reported as conflicts.
+	Overlaps []string `json:"overlaps,omitempty"`
+
+	// Equivalents of Pattern and Regex for matching warp names in memory instead of in the database
+	patternRegexp *regexp.Regexp
+	regexRegexp   *regexp.Regexp
 }
 
 func (company Company) GetID() string {
 	return company.ID
 }
 
+// isOwnedBy returns true if the player UUID (with hyphens) is one of the company's owners.
+func (company Company) isOwnedBy(playerUUID string) bool {
+	for _, owner := range company.Owners {
+		if strings.EqualFold(owner, playerUUID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches returns true if the warp name would be matched by the company's LIKE pattern (and regex, if it has one) in the database.
+func (company Company) matches(warpName string) bool {
+	if company.patternRegexp == nil || !company.patternRegexp.MatchString(warpName) {
+		return false
+	}
+
+	return company.regexRegexp == nil || company.regexRegexp.MatchString(warpName)
+}
+
+// warpCondition returns an expression that matches the company's warps in the database.
+func (company Company) warpCondition() BoolExpression {
+	condition := table.Warp.Name.LIKE(String(company.Pattern))
+
+	if company.Regex != "" {
+		condition = AND(condition, table.Warp.Name.REGEXP_LIKE(String(company.Regex)))
+	}
+
+	return condition
+}
+
 func (company Company) Render(writer http.ResponseWriter, request *http.Request) error {
 	return nil
 }
@@ -46,6 +114,16 @@ type CompanyProvider struct {
 	companies       []Company
 	companiesByID   *orderedmap.OrderedMap[string, Company]
 	companiesByMode *orderedmap.OrderedMap[TransportMode, []Company]
+
+	// Maps of company IDs to the IDs of their predecessors and successors
+	predecessorsByID map[string]string
+	successorsByID   map[string]string
+
+	// Map of company IDs to the IDs of their direct subsidiaries
+	subsidiariesByID map[string][]string
+
+	// Modes that the companies were validated against
+	modeProvider ModeProvider
 }
 
 // getCompanies godoc
@@ -53,26 +131,22 @@ type CompanyProvider struct {
 // @description List all companies (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml).
 // @tags        Companies
 // @produce     json
-// @param       mode query   string false "Filter by transport mode: `warp_rail`, `bus`, `air`, `sea`, or `other`."
-// @success     200  {array} Company
+// @param       mode  query    string false "Filter by transport mode ID (from /modes)."
+// @param       owner query    string false "Filter by owner player UUID (can be with or without hyphens)."
+// @success     200   {array}  Company
+// @failure     400   {object} Error
 // @router      /companies [get]
 func (provider CompanyProvider) getCompanies(writer http.ResponseWriter, request *http.Request) {
 	mode := request.URL.Query().Get("mode")
+	owner := request.URL.Query().Get("owner")
 
-	companies := provider.companies
-
-	if mode != "" {
-		mode_exists := false
-		companies, mode_exists = provider.companiesByMode.Get(TransportMode(mode))
-
-		if !mode_exists {
-			detail := "The 'mode' query parameter must be one of 'warp_rail', 'bus', 'air', 'sea', or 'other'."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
-		}
+	companies, err := provider.listCompanies(mode, owner)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
 	}
 
-	err := render.RenderList(writer, request, toRenderList(companies))
+	err = render.RenderList(writer, request, toRenderList(companies))
 	if err != nil {
 		render.Render(writer, request, ErrorRender(err))
 		return
@@ -81,11 +155,11 @@ func (provider CompanyProvider) getCompanies(writer http.ResponseWriter, request
 
 // getCompanyById godoc
 // @summary       Get company by ID
-// @description   Get company by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml).
+// @description   Get company by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml), including its lineage of predecessors and successors, and its subsidiaries.
 // @tags          Companies
 // @produce       json
 // @param         id  path     string  true "Company ID"
-// @success       200 {object} Company
+// @success       200 {object} CompanyDetail
 // @failure       404 {object} Error
 // @router        /companies/{id} [get]
 func (provider CompanyProvider) getCompanyById(writer http.ResponseWriter, request *http.Request) {
@@ -97,48 +171,369 @@ func (provider CompanyProvider) getCompanyById(writer http.ResponseWriter, reque
 		return
 	}
 
-	err := render.Render(writer, request, company)
+	detail := CompanyDetail{
+		Company:      company,
+		Lineage:      provider.getLineage(company),
+		Subsidiaries: provider.getSubsidiaries(company),
+	}
+
+	err := render.Render(writer, request, detail)
 	if err != nil {
 		render.Render(writer, request, ErrorRender(err))
 		return
 	}
 }
 
-func companiesRouter(provider CompanyProvider) http.Handler {
+// getCompanyLogo godoc
+// @summary     Get company logo
+// @description Get the logo image of a company, if it has one.
+// @tags        Companies
+// @produce     image/png
+// @produce     image/svg+xml
+// @param       id  path     string true "Company ID"
+// @success     200 {file}   file
+// @failure     404 {object} Error
+// @router      /companies/{id}/logo [get]
+func (provider CompanyProvider) getCompanyLogo(writer http.ResponseWriter, request *http.Request) {
+	id := chi.URLParam(request, "id")
+
+	company, exists := provider.companiesByID.Get(id)
+	if !exists || company.Logo == "" {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	http.ServeFile(writer, request, filepath.Join(LOGOS_PATH, company.Logo))
+}
+
+// getCompanyWarps godoc
+// @summary     List warps of company
+// @description List the warps of a company, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.
+// @tags        Companies
+// @produce     json
+// @produce     application/geo+json
+// @param       id             path     string true  "Company ID"
+// @param       format         query    string false "Response format: 'json' (default) or 'geojson'."
+// @param       name           query    string false "Filter by warp name."
+// @param       player         query    string false "Filter by player UUID (can be with or without hyphens)."
+// @param       include_legacy query    bool   false "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter."
+// @param       alliance       query    string false "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance."
+// @param       mode           query    string false "Filter by transport mode ID (from /modes)."
+// @param       world          query    string false "Filter by world ID (from /worlds)."
+// @param       type           query    int    false "Filter by type (0 = private, 1 = public)."
+// @param       region         query    string false "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files."
+// @param       chunk          query    string false "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40')."
+// @param       area           query    string false "Filter by area ID (from /areas), matching the warps that lie within the area."
+// @param       order_by       query    string false "Order by 'name', 'creation_date', or 'visits'."
+// @param       sort_by        query    string false "Sort by 'asc' (ascending) or 'desc' (descending)."
+// @param       limit          query    int    false "Limit number of warps returned. Maximum limit is 2000."
+// @param       offset         query    int    false "Number of warps to skip before returning."
+// @param       coords         query    string false "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8)."
+// @success     200            {object} WarpResponse
+// @failure     400            {object} Error
+// @failure     404            {object} Error
+// @router      /companies/{id}/warps [get]
+func (provider WarpProviderV2) getCompanyWarps(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+
+	if _, exists := provider.companyProvider().companiesByID.Get(id); !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	parameters := warpQueryParametersFromRequest(request)
+	parameters.CompanyID = id
+
+	provider.renderWarps(writer, request, parameters)
+}
+
+// listCompanies returns all companies, or only those with the given transport mode and/or owner if they are not empty.
+func (provider CompanyProvider) listCompanies(mode string, owner string) ([]Company, error) {
+	companies := provider.companies
+
+	if mode != "" {
+		modeCompanies, exists := provider.companiesByMode.Get(TransportMode(mode))
+		if !exists {
+			return nil, errors.New(provider.modeProvider.invalidModeMessage("mode"))
+		}
+
+		companies = modeCompanies
+	}
+
+	if owner != "" {
+		playerUUID, valid := normalizePlayerUUID(owner)
+		if !valid {
+			return nil, errors.New("The 'owner' query parameter must be a UUID that has 32 hexadecimal digits (with or without hyphens).")
+		}
+
+		ownedCompanies := []Company{}
+		for _, company := range companies {
+			if company.isOwnedBy(playerUUID) {
+				ownedCompanies = append(ownedCompanies, company)
+			}
+		}
+
+		companies = ownedCompanies
+	}
+
+	return companies, nil
+}
+
+// findCompanyForWarp returns the first company (in the order defined in companies.yml) whose pattern matches the warp name.
+func (provider CompanyProvider) findCompanyForWarp(warpName string) (Company, bool) {
+	for _, company := range provider.companies {
+		if company.matches(warpName) {
+			return company, true
+		}
+	}
+
+	return Company{}, false
+}
+
+func companiesRouter(warpProvider WarpProviderV2, lineProvider LineProvider) http.Handler {
+	data := warpProvider.data
+
 	router := chi.NewRouter()
-	router.Get("/", provider.getCompanies)
+	router.Get("/", data.companyHandler(CompanyProvider.getCompanies))
+	router.Post("/preview", warpProvider.previewCompany)
 
 	router.Route("/{id}", func(subrouter chi.Router) {
-		subrouter.Get("/", provider.getCompanyById)
+		subrouter.Get("/", data.companyHandler(CompanyProvider.getCompanyById))
+		subrouter.Get("/logo", data.companyHandler(CompanyProvider.getCompanyLogo))
+		subrouter.Get("/warps", warpProvider.getCompanyWarps)
+		subrouter.Get("/lines", lineProvider.getCompanyLines)
 	})
 
 	return router
 }
 
-func loadCompanies() CompanyProvider {
-	companies := loadStaticData[Company](COMPANIES_PATH)
+func readCompanies(modeProvider ModeProvider) (CompanyProvider, error) {
+	companies, err := readStaticData[Company](COMPANIES_PATH)
+	if err != nil {
+		return CompanyProvider{}, err
+	}
+
+	return newCompanyProvider(companies, modeProvider)
+}
+
+// newCompanyProvider checks that each company is valid, and indexes the companies by ID and transport mode.
+// Companies are matched against warps in the order given.
+func newCompanyProvider(companies []Company, modeProvider ModeProvider) (CompanyProvider, error) {
+	for i := range companies {
+		if companies[i].ID == "" {
+			return CompanyProvider{}, fmt.Errorf("The company '%s' has an empty ID", companies[i].Name)
+		}
+
+		if companies[i].ID == NO_COMPANY_ID {
+			return CompanyProvider{}, fmt.Errorf("The company ID '%s' is reserved", NO_COMPANY_ID)
+		}
+
+		patternRegexp, err := likePatternToRegexp(companies[i].Pattern)
+		if err != nil {
+			return CompanyProvider{}, fmt.Errorf("The company '%s' has an invalid pattern: '%s'", companies[i].ID, companies[i].Pattern)
+		}
+
+		companies[i].patternRegexp = patternRegexp
+
+		if companies[i].Regex != "" {
+			regexRegexp, err := compileCompanyRegex(companies[i].Regex)
+			if err != nil {
+				return CompanyProvider{}, fmt.Errorf("The company '%s' has an invalid regex: '%s' (%s)", companies[i].ID, companies[i].Regex, err)
+			}
+
+			companies[i].regexRegexp = regexRegexp
+		}
+
+		err = validateCompanyMetadata(&companies[i])
+		if err != nil {
+			return CompanyProvider{}, err
+		}
+	}
+
 	companiesByID := staticDataToOrderedMap(companies)
+
+	for _, company := range companies {
+		for _, id := range company.Overlaps {
+			if _, exists := companiesByID.Get(id); !exists || id == company.ID {
+				return CompanyProvider{}, fmt.Errorf("The company '%s' has an invalid overlap: '%s'", company.ID, id)
+			}
+		}
+	}
+
 	companiesByMode := orderedmap.New[TransportMode, []Company]()
 
 	// Populate map of transport modes to list of companies
-	for i := range transportModes {
-		companiesByMode.Set(transportModes[i], []Company{})
+	for _, mode := range modeProvider.modes {
+		companiesByMode.Set(mode.ID, []Company{})
 	}
 	for i := range companies {
 		company := companies[i]
 		list, exists := companiesByMode.Get(company.Mode)
 
 		if !exists {
-			message := fmt.Sprintf("The company '%s' has an invalid mode: '%s'", company.ID, company.Mode)
-			panic(message)
+			return CompanyProvider{}, fmt.Errorf("The company '%s' has an invalid mode: '%s'", company.ID, company.Mode)
 		}
 
 		companiesByMode.Set(company.Mode, append(list, company))
 	}
 
+	predecessorsByID, successorsByID, err := buildCompanyLineages(companies, companiesByID)
+	if err != nil {
+		return CompanyProvider{}, err
+	}
+
+	subsidiariesByID, err := buildCompanySubsidiaries(companies, companiesByID)
+	if err != nil {
+		return CompanyProvider{}, err
+	}
+
 	return CompanyProvider{
-		companies:       companies,
-		companiesByID:   companiesByID,
-		companiesByMode: companiesByMode,
+		companies:        companies,
+		companiesByID:    companiesByID,
+		companiesByMode:  companiesByMode,
+		predecessorsByID: predecessorsByID,
+		successorsByID:   successorsByID,
+		subsidiariesByID: subsidiariesByID,
+		modeProvider:     modeProvider,
+	}, nil
+}
+
+// validateCompanyMetadata checks the optional metadata of the company, and adds hyphens to owner UUIDs that are missing them.
+func validateCompanyMetadata(company *Company) error {
+	if company.Colour != "" && !colourRegexp.MatchString(company.Colour) {
+		return fmt.Errorf("The company '%s' has an invalid colour: '%s'", company.ID, company.Colour)
+	}
+
+	if company.Website != "" && !isValidWebsite(company.Website) {
+		return fmt.Errorf("The company '%s' has an invalid website: '%s'", company.ID, company.Website)
+	}
+
+	// Replace the owners instead of modifying them, since they may be shared with the companies currently in use
+	owners := []string{}
+	for _, owner := range company.Owners {
+		playerUUID, valid := normalizePlayerUUID(owner)
+		if !valid {
+			return fmt.Errorf("The company '%s' has an invalid owner UUID: '%s'", company.ID, owner)
+		}
+
+		owners = append(owners, playerUUID)
+	}
+	company.Owners = owners
+
+	if company.Founded != "" {
+		if _, err := time.Parse(DATE_FORMAT, company.Founded); err != nil {
+			return fmt.Errorf("The company '%s' has an invalid founding date (must be YYYY-MM-DD): '%s'", company.ID, company.Founded)
+		}
+	}
+
+	for _, date := range []string{company.ActiveFrom, company.ActiveTo} {
+		if date == "" {
+			continue
+		}
+
+		if _, err := time.Parse(DATE_FORMAT, date); err != nil {
+			return fmt.Errorf("The company '%s' has an invalid active date (must be YYYY-MM-DD): '%s'", company.ID, date)
+		}
+	}
+
+	// Dates in this format can be compared as strings
+	if company.ActiveFrom != "" && company.ActiveTo != "" && company.ActiveTo < company.ActiveFrom {
+		return fmt.Errorf("The company '%s' has an 'active_to' date that is before its 'active_from' date", company.ID)
+	}
+
+	if company.Logo != "" {
+		// Logos must be directly in the logos directory, so that other files cannot be served
+		if filepath.Base(company.Logo) != company.Logo || strings.HasPrefix(company.Logo, ".") {
+			return fmt.Errorf("The company '%s' has an invalid logo file name: '%s'", company.ID, company.Logo)
+		}
+
+		info, err := os.Stat(filepath.Join(LOGOS_PATH, company.Logo))
+		if err != nil || info.IsDir() {
+			return fmt.Errorf("The company '%s' has a logo that does not exist in %s: '%s'", company.ID, LOGOS_PATH, company.Logo)
+		}
+	}
+
+	return nil
+}
+
+// isValidWebsite returns true if the website is an absolute http or https URL.
+func isValidWebsite(website string) bool {
+	websiteURL, err := url.Parse(website)
+	return err == nil && (websiteURL.Scheme == "http" || websiteURL.Scheme == "https") && websiteURL.Host != ""
+}
+
+// compileCompanyRegex compiles a company's regex for matching in memory, rejecting syntax that MySQL's REGEXP does not support.
+// Matching is case-insensitive, in line with REGEXP under the default collation of the MyWarp database.
+func compileCompanyRegex(regex string) (*regexp.Regexp, error) {
+	escaped := false
+
+	// Index where the text quoted by '\Q' ends, which is a literal in both Go and MySQL
+	quoteEnd := 0
+
+	for i, character := range regex {
+		switch {
+		case i < quoteEnd:
+			continue
+		case escaped:
+			escaped = false
+
+			switch {
+			case character >= '0' && character <= '9':
+				return nil, errors.New("octal escapes are back-references in MySQL, use '\\x' escapes instead")
+			case character == 'v':
+				return nil, errors.New("'\\v' matches any vertical whitespace in MySQL, use '\\x0B' for a vertical tab instead")
+			case (character == 'p' || character == 'P') && !strings.HasPrefix(regex[i+1:], "{"):
+				return nil, errors.New("Unicode classes must be written with braces in MySQL (e.g. '\\p{L}')")
+			case character == 'Q':
+				quoteEnd = len(regex)
+				if end := strings.Index(regex[i:], "\\E"); end >= 0 {
+					quoteEnd = i + end + 2
+				}
+			}
+		case character == '\\':
+			escaped = true
+		case strings.HasPrefix(regex[i:], "(?P<"):
+			return nil, errors.New("named groups are not supported by MySQL")
+		case strings.HasPrefix(regex[i:], "(?"):
+			flags := regex[i+2:]
+			if end := strings.IndexAny(flags, ":)"); end >= 0 && strings.Contains(flags[:end], "U") {
+				return nil, errors.New("the 'U' flag is not supported by MySQL")
+			}
+		}
 	}
+
+	return regexp.Compile("(?i)" + regex)
+}
+
+// likePatternToRegexp converts a MySQL LIKE pattern into an equivalent regular expression.
+// Matching is case-insensitive, in line with the default collation of the MyWarp database.
+func likePatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	var builder strings.Builder
+	builder.WriteString("(?is)^")
+
+	escaped := false
+	for _, character := range pattern {
+		switch {
+		case escaped:
+			builder.WriteString(regexp.QuoteMeta(string(character)))
+			escaped = false
+		case character == '\\':
+			escaped = true
+		case character == '%':
+			builder.WriteString(".*")
+		case character == '_':
+			builder.WriteString(".")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(character)))
+		}
+	}
+
+	if escaped {
+		return nil, fmt.Errorf("pattern '%s' ends with an escape character", pattern)
+	}
+
+	builder.WriteString("$")
+	return regexp.Compile(builder.String())
 }
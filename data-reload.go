@@ -0,0 +1,311 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Time to wait after the last change to a data file before reloading, since editors often write a file in several steps
+const RELOAD_DELAY = time.Second
+
+// Modes, companies, alliances, worlds and areas that are loaded together, so that they are always consistent with each other
+type DataSnapshot struct {
+	modeProvider     ModeProvider
+	companyProvider  CompanyProvider
+	allianceProvider AllianceProvider
+	worldProvider    WorldProvider
+	areaProvider     AreaProvider
+}
+
+// Holds the current modes, companies, alliances, worlds and areas, which can be replaced while the server is running
+type DataStore struct {
+	snapshot atomic.Pointer[DataSnapshot]
+
+	// Held while replacing the snapshot, so that concurrent changes are not lost
+	mutex sync.Mutex
+}
+
+func (data *DataStore) modeProvider() ModeProvider {
+	return data.snapshot.Load().modeProvider
+}
+
+func (data *DataStore) companyProvider() CompanyProvider {
+	return data.snapshot.Load().companyProvider
+}
+
+func (data *DataStore) allianceProvider() AllianceProvider {
+	return data.snapshot.Load().allianceProvider
+}
+
+func (data *DataStore) worldProvider() WorldProvider {
+	return data.snapshot.Load().worldProvider
+}
+
+func (data *DataStore) areaProvider() AreaProvider {
+	return data.snapshot.Load().areaProvider
+}
+
+// modeHandler returns a handler that calls a ModeProvider method on the modes that are current at the time of the request.
+func (data *DataStore) modeHandler(handler func(ModeProvider, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		handler(data.modeProvider(), writer, request)
+	}
+}
+
+// companyHandler returns a handler that calls a CompanyProvider method on the companies that are current at the time of the request.
+func (data *DataStore) companyHandler(handler func(CompanyProvider, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		handler(data.companyProvider(), writer, request)
+	}
+}
+
+// allianceHandler returns a handler that calls an AllianceProvider method on the alliances that are current at the time of the request.
+func (data *DataStore) allianceHandler(handler func(AllianceProvider, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		handler(data.allianceProvider(), writer, request)
+	}
+}
+
+// areaHandler returns a handler that calls an AreaProvider method on the areas that are current at the time of the request.
+func (data *DataStore) areaHandler(handler func(AreaProvider, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		handler(data.areaProvider(), writer, request)
+	}
+}
+
+func loadDataStore(companyStore *CompanyStore) *DataStore {
+	snapshot, err := readDataSnapshot(context.Background(), companyStore)
+	checkForErrors(err)
+
+	data := &DataStore{}
+	data.snapshot.Store(&snapshot)
+	return data
+}
+
+// readDataSnapshot reads the companies from the company store if there is one, or from companies.yml otherwise.
+func readDataSnapshot(ctx context.Context, companyStore *CompanyStore) (DataSnapshot, error) {
+	modeProvider, err := readModes()
+	if err != nil {
+		return DataSnapshot{}, err
+	}
+
+	var companyProvider CompanyProvider
+
+	if companyStore != nil {
+		var companies []Company
+		companies, err = companyStore.listCompanies(ctx)
+		if err == nil {
+			companyProvider, err = newCompanyProvider(companies, modeProvider)
+		}
+	} else {
+		companyProvider, err = readCompanies(modeProvider)
+	}
+	if err != nil {
+		return DataSnapshot{}, err
+	}
+
+	allianceProvider, err := readAlliances(companyProvider)
+	if err != nil {
+		return DataSnapshot{}, err
+	}
+
+	worldProvider, err := readWorlds()
+	if err != nil {
+		return DataSnapshot{}, err
+	}
+
+	areaProvider, err := readAreas(worldProvider)
+	if err != nil {
+		return DataSnapshot{}, err
+	}
+
+	return DataSnapshot{
+		modeProvider:     modeProvider,
+		companyProvider:  companyProvider,
+		allianceProvider: allianceProvider,
+		worldProvider:    worldProvider,
+		areaProvider:     areaProvider,
+	}, nil
+}
+
+// Returned when new data is rejected because it is invalid
+type DataValidationError struct {
+	Detail string
+}
+
+func (err DataValidationError) Error() string {
+	return err.Detail
+}
+
+type DataReloader struct {
+	warpProvider WarpProviderV2
+	lineProvider LineProvider
+
+	// If not nil, companies are read from this store instead of companies.yml
+	companyStore *CompanyStore
+
+	// If true, reject any reload with company conflicts, not just errors
+	strict bool
+}
+
+// reload reads the modes, companies, alliances, worlds and areas again, and replaces the current data only if the new data passes validation.
+func (reloader DataReloader) reload(ctx context.Context) error {
+	data := reloader.warpProvider.data
+	data.mutex.Lock()
+	defer data.mutex.Unlock()
+
+	snapshot, err := readDataSnapshot(ctx, reloader.companyStore)
+	if err != nil {
+		return err
+	}
+
+	err = reloader.validate(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+
+	data.snapshot.Store(&snapshot)
+	return nil
+}
+
+// updateCompanies applies the edit to the current list of companies, and if the result passes validation,
+// saves it to the company store (recording the actor in the audit records) and makes it current.
+func (reloader DataReloader) updateCompanies(ctx context.Context, actor string, edit func(companies []Company) ([]Company, error)) error {
+	data := reloader.warpProvider.data
+	data.mutex.Lock()
+	defer data.mutex.Unlock()
+
+	// The snapshot cannot be replaced by anyone else while the mutex is held, so it only needs to be loaded once
+	current := data.snapshot.Load()
+	previous := current.companyProvider.companies
+
+	companies, err := edit(append([]Company{}, previous...))
+	if err != nil {
+		return err
+	}
+
+	companyProvider, err := newCompanyProvider(companies, current.modeProvider)
+	if err != nil {
+		return DataValidationError{err.Error()}
+	}
+
+	// Companies must still refer to existing alliances
+	allianceProvider, err := newAllianceProvider(current.allianceProvider.alliances, companyProvider)
+	if err != nil {
+		return DataValidationError{err.Error()}
+	}
+
+	snapshot := DataSnapshot{
+		modeProvider:     current.modeProvider,
+		companyProvider:  companyProvider,
+		allianceProvider: allianceProvider,
+		worldProvider:    current.worldProvider,
+		areaProvider:     current.areaProvider,
+	}
+
+	err = reloader.validate(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+
+	err = reloader.companyStore.saveCompanies(ctx, previous, companies, actor)
+	if err != nil {
+		return err
+	}
+
+	data.snapshot.Store(&snapshot)
+	return nil
+}
+
+// validate checks the companies for conflicts, and that all lines still refer to existing companies and worlds.
+func (reloader DataReloader) validate(ctx context.Context, snapshot DataSnapshot) error {
+	report, err := reloader.warpProvider.validateCompanies(ctx, snapshot.companyProvider.companies)
+	if err != nil {
+		return err
+	}
+
+	report.log()
+	if report.fails(reloader.strict) {
+		messages := []string{}
+		for _, conflict := range report.Conflicts {
+			if conflict.Severity == SeverityError || reloader.strict {
+				messages = append(messages, conflict.Message)
+			}
+		}
+
+		return DataValidationError{"The companies have conflicts that must be resolved: " + strings.Join(messages, "; ")}
+	}
+
+	err = reloader.lineProvider.validateReferences(snapshot.companyProvider, snapshot.worldProvider)
+	if err != nil {
+		return DataValidationError{err.Error()}
+	}
+
+	return nil
+}
+
+// watch reloads the data whenever the server receives SIGHUP, or whenever modes.yml, companies.yml (unless companies are read from the company store), alliances.yml, worlds.yml or areas.yml is changed.
+// If a reload fails, the previous data continues to be used.
+func (reloader DataReloader) watch() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	// Watch the directory instead of the files, since editors often replace a file rather than writing to it
+	paths := []string{filepath.Clean(MODES_PATH), filepath.Clean(ALLIANCES_PATH), filepath.Clean(WORLDS_PATH), filepath.Clean(AREAS_PATH)}
+	if reloader.companyStore == nil {
+		paths = append(paths, filepath.Clean(COMPANIES_PATH))
+	}
+
+	var events chan fsnotify.Event
+	var watchErrors chan error
+
+	watcher, err := fsnotify.NewWatcher()
+	if err == nil {
+		defer watcher.Close()
+		for _, path := range paths {
+			if err = watcher.Add(filepath.Dir(path)); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		log.Println("Unable to watch data files, use SIGHUP to reload instead: ", err)
+	} else {
+		events = watcher.Events
+		watchErrors = watcher.Errors
+	}
+
+	timer := time.NewTimer(RELOAD_DELAY)
+	timer.Stop()
+
+	for {
+		select {
+		case <-signals:
+			timer.Reset(0)
+		case event := <-events:
+			if containsString(paths, filepath.Clean(event.Name)) && !event.Has(fsnotify.Chmod) {
+				timer.Reset(RELOAD_DELAY)
+			}
+		case err := <-watchErrors:
+			log.Println("Error watching data files: ", err)
+		case <-timer.C:
+			err := reloader.reload(context.Background())
+			if err != nil {
+				log.Println("Data reload failed, keeping previous data: ", err)
+			} else {
+				log.Println("Modes, companies, alliances, worlds and areas reloaded")
+			}
+		}
+	}
+}
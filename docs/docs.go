@@ -15,21 +15,89 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/companies": {
+        "/admin/companies": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a company, which is matched against warps after all existing companies.\nThe company is rejected if it is invalid or has conflicts with other companies (see /reports/companies) that would prevent the server from starting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Create company",
+                "parameters": [
+                    {
+                        "description": "Company",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.Company"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.Company"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/companies/audit": {
             "get": {
-                "description": "List all companies (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml).",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the changes made to companies, most recent first, including who made each change and the company before and after it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Companies"
+                    "Admin"
                 ],
-                "summary": "List all companies",
+                "summary": "List company audit records",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by transport mode: ` + "`" + `warp_rail` + "`" + `, ` + "`" + `bus` + "`" + `, ` + "`" + `air` + "`" + `, ` + "`" + `sea` + "`" + `, or ` + "`" + `other` + "`" + `.",
-                        "name": "mode",
+                        "description": "Filter by company ID.",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of records returned. Default is 100, maximum is 1000.",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -39,41 +107,58 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/main.Company"
+                                "$ref": "#/definitions/main.CompanyAuditRecord"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
                     }
                 }
             }
         },
-        "/companies/{id}": {
-            "get": {
-                "description": "Get company by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml).",
+        "/admin/companies/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace all companies with those in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml (as deployed on the server). Every company that is created, updated or deleted as a result is recorded in the audit records.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Companies"
-                ],
-                "summary": "Get company by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Company ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Admin"
                 ],
+                "summary": "Import companies",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/main.Company"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Company"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/main.Error"
                         }
@@ -81,108 +166,151 @@ const docTemplate = `{
                 }
             }
         },
-        "/warps": {
-            "get": {
-                "description": "List all warps. Maximum number of warps returned per request is 2000. Use the 'offset' query parameter to show further entries.",
+        "/admin/companies/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace an existing company, keeping its position in the order that companies are matched against warps. The ID of a company cannot be changed.\nThe company is rejected if it is invalid or has conflicts with other companies (see /reports/companies) that would prevent the server from starting.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Warps"
+                    "Admin"
                 ],
-                "summary": "List all warps",
+                "summary": "Update company",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by warp name.",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by player UUID (can be with or without hyphens).",
-                        "name": "player",
-                        "in": "query"
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by company ID (from /companies).",
+                        "description": "Company",
                         "name": "company",
-                        "in": "query"
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.Company"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.Company"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Filter by transport mode: ` + "`" + `warp_rail` + "`" + `, ` + "`" + `bus` + "`" + `, ` + "`" + `air` + "`" + `, ` + "`" + `sea` + "`" + `, or ` + "`" + `other` + "`" + `.",
-                        "name": "mode",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Filter by world ID (from /worlds).",
-                        "name": "world",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "Filter by type (0 = private, 1 = public).",
-                        "name": "type",
-                        "in": "query"
-                    },
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a company. A company cannot be deleted while it is used by any lines.",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete company",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Order by 'name', 'creation_date', or 'visits'.",
-                        "name": "order_by",
-                        "in": "query"
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
-                    {
-                        "type": "string",
-                        "description": "Sort by 'asc' (ascending) or 'desc' (descending).",
-                        "name": "sort_by",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "description": "Limit number of warps returned. Maximum limit is 2000.",
-                        "name": "limit",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "description": "Number of warps to skip before returning.",
-                        "name": "offset",
-                        "in": "query"
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
                     }
+                }
+            }
+        },
+        "/alliances": {
+            "get": {
+                "description": "List all alliances of companies (defined in https://github.com/Frumple/mrt-api/blob/main/data/alliances.yml).",
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "Alliances"
+                ],
+                "summary": "List all alliances",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/main.WarpResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/main.Error"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Alliance"
+                            }
                         }
                     }
                 }
             }
         },
-        "/warps/{id}": {
+        "/alliances/{id}": {
             "get": {
-                "description": "Get warp by ID.",
+                "description": "Get alliance by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/alliances.yml), including all of its companies and their transport modes.\nCompanies are members of an alliance if they list it in their 'alliances', or if they are a subsidiary of such a company.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Warps"
+                    "Alliances"
                 ],
-                "summary": "Get warp by ID",
+                "summary": "Get alliance by ID",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Warp ID",
+                        "type": "string",
+                        "description": "Alliance ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -192,13 +320,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/main.Warp"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/main.Error"
+                            "$ref": "#/definitions/main.AllianceDetail"
                         }
                     },
                     "404": {
@@ -210,43 +332,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/worlds": {
+        "/areas": {
             "get": {
-                "description": "List all worlds (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml).",
+                "description": "List all areas, such as cities (defined in https://github.com/Frumple/mrt-api/blob/main/data/areas.yml).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Worlds"
+                    "Areas"
+                ],
+                "summary": "List all areas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by world ID (from /worlds).",
+                        "name": "world",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all worlds",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/main.World"
+                                "$ref": "#/definitions/main.Area"
                             }
                         }
                     }
                 }
             }
         },
-        "/worlds/{id}": {
+        "/areas/{id}": {
             "get": {
-                "description": "Get world by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml).",
+                "description": "Get area by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/areas.yml).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Worlds"
+                    "Areas"
                 ],
-                "summary": "Get world by ID",
+                "summary": "Get area by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "World ID",
+                        "description": "Area ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -256,7 +386,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/main.World"
+                            "$ref": "#/definitions/main.Area"
                         }
                     },
                     "404": {
@@ -267,66 +397,2598 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "main.Company": {
-            "type": "object",
-            "properties": {
-                "id": {
+        },
+        "/areas/{id}/warps": {
+            "get": {
+                "description": "List the warps that lie within an area, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "Areas"
+                ],
+                "summary": "List warps in area",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Area ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: 'json' (default) or 'geojson'.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by warp name.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by player UUID (can be with or without hyphens).",
+                        "name": "player",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company.",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter.",
+                        "name": "include_legacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance.",
+                        "name": "alliance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by type (0 = private, 1 = public).",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files.",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40').",
+                        "name": "chunk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order by 'name', 'creation_date', or 'visits'.",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by 'asc' (ascending) or 'desc' (descending).",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of warps returned. Maximum limit is 2000.",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of warps to skip before returning.",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8).",
+                        "name": "coords",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WarpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies": {
+            "get": {
+                "description": "List all companies (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "List all companies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by owner player UUID (can be with or without hyphens).",
+                        "name": "owner",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Company"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/preview": {
+            "post": {
+                "description": "Show which warps a proposed company would match, before it is added to https://github.com/Frumple/mrt-api/blob/main/data/companies.yml.\nWarps are matched in the same way as the 'company' filter of /warps. The response includes the number of matching warps, the first matching warps, and the existing companies whose warps would also be matched.\nPreviews that take longer than 10 seconds are cancelled, and return a bad request instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Preview company pattern",
+                "parameters": [
+                    {
+                        "description": "Proposed pattern, and optionally regex and transport mode",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CompanyPreviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CompanyPreview"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}": {
+            "get": {
+                "description": "Get company by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml), including its lineage of predecessors and successors, and its subsidiaries.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Get company by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CompanyDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/lines": {
+            "get": {
+                "description": "List all lines operated by a company (defined in https://github.com/Frumple/mrt-api/blob/main/data/lines.yml).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "List lines of company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Line"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/logo": {
+            "get": {
+                "description": "Get the logo image of a company, if it has one.",
+                "produces": [
+                    "image/png",
+                    "image/svg+xml"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Get company logo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/warps": {
+            "get": {
+                "description": "List the warps of a company, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "List warps of company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: 'json' (default) or 'geojson'.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by warp name.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by player UUID (can be with or without hyphens).",
+                        "name": "player",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter.",
+                        "name": "include_legacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance.",
+                        "name": "alliance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by world ID (from /worlds).",
+                        "name": "world",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by type (0 = private, 1 = public).",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files.",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40').",
+                        "name": "chunk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by area ID (from /areas), matching the warps that lie within the area.",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order by 'name', 'creation_date', or 'visits'.",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by 'asc' (ascending) or 'desc' (descending).",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of warps returned. Maximum limit is 2000.",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of warps to skip before returning.",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8).",
+                        "name": "coords",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WarpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/lines": {
+            "get": {
+                "description": "List all lines (defined in https://github.com/Frumple/mrt-api/blob/main/data/lines.yml).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lines"
+                ],
+                "summary": "List all lines",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies).",
+                        "name": "company",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Line"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/lines/{id}": {
+            "get": {
+                "description": "Get line by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/lines.yml), including the warps that match each stop.\nStops that do not match any warps are flagged as missing.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lines"
+                ],
+                "summary": "Get line by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Line ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.LineDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/modes": {
+            "get": {
+                "description": "List all transport modes that companies can have (defined in https://github.com/Frumple/mrt-api/blob/main/data/modes.yml).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modes"
+                ],
+                "summary": "List all transport modes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Mode"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/modes/{id}": {
+            "get": {
+                "description": "Get transport mode by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/modes.yml).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modes"
+                ],
+                "summary": "Get transport mode by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mode ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.Mode"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/{uuid}/warps": {
+            "get": {
+                "description": "List the warps owned by a player, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "Players"
+                ],
+                "summary": "List warps of player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player UUID (can be with or without hyphens)",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: 'json' (default) or 'geojson'.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by warp name.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company.",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter.",
+                        "name": "include_legacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance.",
+                        "name": "alliance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by world ID (from /worlds).",
+                        "name": "world",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by type (0 = private, 1 = public).",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files.",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40').",
+                        "name": "chunk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by area ID (from /areas), matching the warps that lie within the area.",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order by 'name', 'creation_date', or 'visits'.",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by 'asc' (ascending) or 'desc' (descending).",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of warps returned. Maximum limit is 2000.",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of warps to skip before returning.",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8).",
+                        "name": "coords",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WarpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/companies": {
+            "get": {
+                "description": "List conflicts between companies: duplicate IDs, pairs of patterns that can match the same warp name, warps that are matched by more than one company, companies that do not match any warps, and company regexes that match warps differently in MySQL and Go.\nEach conflict includes example warp names. Where patterns overlap, warps belong to whichever company is listed first in data/companies.yml.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Report company conflicts",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CompanyValidationReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/duplicates": {
+            "get": {
+                "description": "List groups of warps from different owners that are at the same coordinates (within a tolerance) in the same world, or that have the same normalized name.\nNames are normalized by ignoring case and any characters that are not letters or digits.\nEach coordinate group starts from its oldest warp, and only contains warps within the tolerance of that warp, so warps in the same group are at most twice the tolerance apart.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Report duplicate warps",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by world ID (from /worlds).",
+                        "name": "world",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum distance (in blocks) between warps at the same coordinates. Default is 1, maximum is 16.",
+                        "name": "tolerance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only report duplicates by 'coordinates' or 'name'.",
+                        "name": "by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also report groups whose warps all have the same owner.",
+                        "name": "include_same_owner",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.DuplicateReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/routes": {
+            "get": {
+                "description": "Find itineraries between two warps or coordinates, using the lines of companies with a transport mode that has a speed in the routing config, and walking transfers between nearby warps.\nLines are taken from /lines where defined, otherwise they are inferred from the names and locations of each company's warps.\nItineraries are ranked by estimated duration (in seconds), and distances are measured in blocks.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Routes"
+                ],
+                "summary": "Plan routes between two locations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Starting warp ID, or coordinates in the format 'x,z'.",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Destination warp ID, or coordinates in the format 'x,z'.",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "World ID (from /worlds). Required if both 'from' and 'to' are coordinates.",
+                        "name": "world",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum walking distance (in blocks) between two warps when transferring. Default and maximum is the max_transfer_distance of the routing config.",
+                        "name": "max_walk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of itineraries returned. Default is 3, maximum is 10.",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.RouteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations": {
+            "get": {
+                "description": "List all stations. Stations are clusters of company warps in the same world that are close to each other, and are recalculated periodically.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stations"
+                ],
+                "summary": "List all stations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by world ID (from /worlds).",
+                        "name": "world",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies).",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Station"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{id}": {
+            "get": {
+                "description": "Get station by ID, including all of its warps.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stations"
+                ],
+                "summary": "Get station by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Station ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.StationDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/warps": {
+            "get": {
+                "description": "List all warps. Maximum number of warps returned per request is 2000. Use the 'offset' query parameter to show further entries.\nSet 'format' to 'geojson' (or the Accept header to 'application/geo+json') to return a GeoJSON FeatureCollection of points at (x, z) instead. An explicit 'format' takes precedence over the Accept header. Coordinates are only comparable within the same world, so combine this with the 'world' filter.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "Warps"
+                ],
+                "summary": "List all warps",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Response format: 'json' (default) or 'geojson'.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by warp name.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by player UUID (can be with or without hyphens).",
+                        "name": "player",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company.",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter.",
+                        "name": "include_legacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance.",
+                        "name": "alliance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by world ID (from /worlds).",
+                        "name": "world",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by type (0 = private, 1 = public).",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files.",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40').",
+                        "name": "chunk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by area ID (from /areas), matching the warps that lie within the area.",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order by 'name', 'creation_date', or 'visits'.",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by 'asc' (ascending) or 'desc' (descending).",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of warps returned. Maximum limit is 2000.",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of warps to skip before returning.",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8).",
+                        "name": "coords",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WarpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/warps/export": {
+            "get": {
+                "description": "Export all warps as newline-delimited JSON or CSV. Unlike /warps, there is no limit on the number of warps returned. Rows are streamed directly from the database as they are read.",
+                "produces": [
+                    "application/x-ndjson",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Warps"
+                ],
+                "summary": "Export all warps",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export format: 'ndjson' (default) or 'csv'.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by warp name.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by player UUID (can be with or without hyphens).",
+                        "name": "player",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company.",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter.",
+                        "name": "include_legacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance.",
+                        "name": "alliance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by world ID (from /worlds).",
+                        "name": "world",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by type (0 = private, 1 = public).",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files.",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40').",
+                        "name": "chunk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by area ID (from /areas), matching the warps that lie within the area.",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order by 'name', 'creation_date', or 'visits'.",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by 'asc' (ascending) or 'desc' (descending).",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8).",
+                        "name": "coords",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Warp"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/warps/{id}": {
+            "get": {
+                "description": "Get warp by ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Warps"
+                ],
+                "summary": "Get warp by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Warp ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.Warp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/warps/{id}/corresponding": {
+            "get": {
+                "description": "Get the location in the paired world (from the 'pair' of the warp's world in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml) that corresponds to the warp, and the nearest warps to that location.\nOverworld coordinates are divided by 8 to get nether coordinates, and nether coordinates are multiplied by 8 to get overworld coordinates. Only the horizontal (x, z) position is used.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Warps"
+                ],
+                "summary": "Get corresponding location of warp",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Warp ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum distance (in blocks of the paired world) of warps from the corresponding location. Default is 128, maximum is 1024.",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of warps returned. Default is 10, maximum is 100.",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CorrespondingLocation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/worlds": {
+            "get": {
+                "description": "List all worlds (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml), with the number of warps in each world.\nSet 'include_unregistered' to 'true' to also list worlds in the MyWarp database that are not defined in worlds.yml. These only have a UUID and number of warps, and are listed last.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worlds"
+                ],
+                "summary": "List all worlds",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include worlds that are not defined in worlds.yml.",
+                        "name": "include_unregistered",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.WorldWithWarpCount"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/worlds/{id}": {
+            "get": {
+                "description": "Get world by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml), with the number of warps in the world.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worlds"
+                ],
+                "summary": "Get world by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "World ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WorldWithWarpCount"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/worlds/{id}/markers": {
+            "get": {
+                "description": "Get markers for all company warps in a world, with one marker set per company (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml).\nDynmap markers are returned as YAML in the format of markers.yml. BlueMap markers are returned as JSON in the format of markers.json.",
+                "produces": [
+                    "application/yaml",
+                    "application/json"
+                ],
+                "tags": [
+                    "Worlds"
+                ],
+                "summary": "Get map markers for world",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "World ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Marker format: 'dynmap' (default) or 'bluemap'.",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/main.BlueMapMarkerSet"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/worlds/{id}/regions": {
+            "get": {
+                "description": "List the region files of a world that contain at least one warp, with the number of warps in each. Regions are ordered by x, then z.\nRegion files that are not listed do not contain any warps.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worlds"
+                ],
+                "summary": "List regions with warps in world",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "World ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.WorldRegion"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/worlds/{id}/warps": {
+            "get": {
+                "description": "List the warps in a world, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.",
+                "produces": [
+                    "application/json",
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "Worlds"
+                ],
+                "summary": "List warps in world",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "World ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: 'json' (default) or 'geojson'.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by warp name.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by player UUID (can be with or without hyphens).",
+                        "name": "player",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company.",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter.",
+                        "name": "include_legacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance.",
+                        "name": "alliance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by type (0 = private, 1 = public).",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files.",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40').",
+                        "name": "chunk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by area ID (from /areas), matching the warps that lie within the area.",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order by 'name', 'creation_date', or 'visits'.",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by 'asc' (ascending) or 'desc' (descending).",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of warps returned. Maximum limit is 2000.",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of warps to skip before returning.",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8).",
+                        "name": "coords",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WarpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/worlds/{id}/waypoints": {
+            "get": {
+                "description": "Get waypoints for warps in a world, for use in client-side minimap mods. Waypoints are coloured by the transport mode of the company that each warp belongs to.\nXaero's Minimap waypoints are returned as a single text file, to be placed in XaeroWaypoints/\u003cserver\u003e/\u003cworld\u003e/.\nJourneyMap waypoints are returned as a zip file of JSON files, to be extracted into journeymap/data/mp/\u003cserver\u003e/waypoints/.\nVoxelMap waypoints are returned as a single .points file, to be placed in voxelmap/.",
+                "produces": [
+                    "text/plain",
+                    "application/zip"
+                ],
+                "tags": [
+                    "Worlds"
+                ],
+                "summary": "Get minimap waypoints for world",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "World ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Waypoint format: 'xaero', 'journeymap', or 'voxelmap'.",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by warp name.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by player UUID (can be with or without hyphens).",
+                        "name": "player",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company.",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter.",
+                        "name": "include_legacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance.",
+                        "name": "alliance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transport mode ID (from /modes).",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by type (0 = private, 1 = public).",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files.",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40').",
+                        "name": "chunk",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by area ID (from /areas), matching the warps that lie within the area.",
+                        "name": "area",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.Error"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "main.Alliance": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Optional metadata",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.AllianceDetail": {
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "description": "Every company in the alliance, including subsidiaries of its members, in the order that companies are defined",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Company"
+                    }
+                },
+                "description": {
+                    "description": "Optional metadata",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "modes": {
+                    "description": "Transport modes of the companies in the alliance",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.TransportMode"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.Area": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Optional metadata",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "polygon": {
+                    "description": "Corners of the polygon as (x, z) pairs, in order around the area",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "world": {
+                    "description": "ID of the world that the area is in",
+                    "type": "string"
+                }
+            }
+        },
+        "main.AuditAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "CreateAction",
+                "UpdateAction",
+                "DeleteAction"
+            ]
+        },
+        "main.BlueMapMarker": {
+            "type": "object",
+            "properties": {
+                "icon": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "position": {
+                    "$ref": "#/definitions/main.BlueMapPosition"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.BlueMapMarkerSet": {
+            "type": "object",
+            "properties": {
+                "default-hidden": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "markers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/main.BlueMapMarker"
+                    }
+                },
+                "sorting": {
+                    "type": "integer"
+                },
+                "toggleable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "main.BlueMapPosition": {
+            "type": "object",
+            "properties": {
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.Company": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "description": "Dates between which the company was active, in the format \"YYYY-MM-DD\"",
+                    "type": "string"
+                },
+                "activeTo": {
+                    "type": "string"
+                },
+                "alliances": {
+                    "description": "IDs of the alliances (defined in alliances.yml) that this company is a member of",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "colour": {
+                    "description": "Optional metadata",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "founded": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "logo": {
+                    "description": "File name of the logo in data/logos, served by /companies/{id}/logo",
+                    "type": "string"
+                },
+                "mode": {
+                    "$ref": "#/definitions/main.TransportMode"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "overlaps": {
+                    "description": "IDs of the companies whose patterns are known to match some of the same warp names as this company.\nWarps go to whichever company is listed first, so these overlaps are not reported as conflicts.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "owners": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent": {
+                    "description": "ID of the company that this company is a subsidiary of",
+                    "type": "string"
+                },
+                "pattern": {
+                    "type": "string"
+                },
+                "predecessor": {
+                    "description": "IDs of the companies that this company replaced, or was replaced by",
+                    "type": "string"
+                },
+                "regex": {
+                    "description": "Optional regular expression that warp names must also match, for rules that a LIKE pattern cannot express.\nIt is evaluated with MySQL's REGEXP in the database and with Go's regexp in memory, so only syntax supported by both should be used.",
+                    "type": "string"
+                },
+                "successor": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CompanyAuditRecord": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/main.AuditAction"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "companyID": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "current": {
+                    "$ref": "#/definitions/main.Company"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "previous": {
+                    "$ref": "#/definitions/main.Company"
+                }
+            }
+        },
+        "main.CompanyConflict": {
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "examples": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "severity": {
+                    "$ref": "#/definitions/main.ConflictSeverity"
+                },
+                "type": {
+                    "$ref": "#/definitions/main.ConflictType"
+                }
+            }
+        },
+        "main.CompanyDetail": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "description": "Dates between which the company was active, in the format \"YYYY-MM-DD\"",
+                    "type": "string"
+                },
+                "activeTo": {
+                    "type": "string"
+                },
+                "alliances": {
+                    "description": "IDs of the alliances (defined in alliances.yml) that this company is a member of",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "colour": {
+                    "description": "Optional metadata",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "founded": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lineage": {
+                    "description": "Every company in the same lineage as this company (including itself), from the earliest predecessor to the latest successor",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Company"
+                    }
+                },
+                "logo": {
+                    "description": "File name of the logo in data/logos, served by /companies/{id}/logo",
+                    "type": "string"
+                },
+                "mode": {
+                    "$ref": "#/definitions/main.TransportMode"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "overlaps": {
+                    "description": "IDs of the companies whose patterns are known to match some of the same warp names as this company.\nWarps go to whichever company is listed first, so these overlaps are not reported as conflicts.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "owners": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent": {
+                    "description": "ID of the company that this company is a subsidiary of",
+                    "type": "string"
+                },
+                "pattern": {
+                    "type": "string"
+                },
+                "predecessor": {
+                    "description": "IDs of the companies that this company replaced, or was replaced by",
+                    "type": "string"
+                },
+                "regex": {
+                    "description": "Optional regular expression that warp names must also match, for rules that a LIKE pattern cannot express.\nIt is evaluated with MySQL's REGEXP in the database and with Go's regexp in memory, so only syntax supported by both should be used.",
+                    "type": "string"
+                },
+                "subsidiaries": {
+                    "description": "Companies that are direct subsidiaries of this company",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Company"
+                    }
+                },
+                "successor": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CompanyPreview": {
+            "type": "object",
+            "properties": {
+                "matches": {
+                    "description": "Number of warps matched by the pattern (and regex)",
+                    "type": "integer"
+                },
+                "mode": {
+                    "$ref": "#/definitions/main.TransportMode"
+                },
+                "overlaps": {
+                    "description": "Existing companies whose warps are also matched, ordered by the number of warps matched (highest first)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CompanyPreviewOverlap"
+                    }
+                },
+                "pattern": {
+                    "type": "string"
+                },
+                "regex": {
+                    "type": "string"
+                },
+                "unclaimed": {
+                    "description": "Number of matched warps that do not belong to any existing company, which the company would receive if it was added after all existing companies",
+                    "type": "integer"
+                },
+                "warps": {
+                    "description": "The first matched warps, ordered by name",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Warp"
+                    }
+                }
+            }
+        },
+        "main.CompanyPreviewOverlap": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "description": "ID of the existing company that the warps currently belong to",
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "examples": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "main.CompanyPreviewRequest": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "$ref": "#/definitions/main.TransportMode"
+                },
+                "pattern": {
+                    "type": "string"
+                },
+                "regex": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CompanyValidationReport": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CompanyConflict"
+                    }
+                }
+            }
+        },
+        "main.ConflictSeverity": {
+            "type": "string",
+            "enum": [
+                "error",
+                "warning"
+            ],
+            "x-enum-varnames": [
+                "SeverityError",
+                "SeverityWarning"
+            ]
+        },
+        "main.ConflictType": {
+            "type": "string",
+            "enum": [
+                "duplicate_id",
+                "pattern_overlap",
+                "warp_overlap",
+                "no_warps",
+                "regex_mismatch"
+            ],
+            "x-enum-varnames": [
+                "DuplicateID",
+                "PatternOverlap",
+                "WarpOverlap",
+                "NoWarps",
+                "RegexMismatch"
+            ]
+        },
+        "main.CorrespondingLocation": {
+            "type": "object",
+            "properties": {
+                "warp": {
+                    "description": "The warp that the location corresponds to",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/main.Warp"
+                        }
+                    ]
+                },
+                "warps": {
+                    "description": "Warps in the paired world that are within the radius of the position, ordered by distance (nearest first)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.WarpWithDistance"
+                    }
+                },
+                "world": {
+                    "description": "ID of the paired world, and the position in it that corresponds to the warp",
+                    "type": "string"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.DuplicateGroup": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "Normalized name, or rounded coordinates in the format 'x,y,z'",
+                    "type": "string"
+                },
+                "playerUUIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "warps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Warp"
+                    }
+                }
+            }
+        },
+        "main.DuplicateReport": {
+            "type": "object",
+            "properties": {
+                "coordinateGroups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DuplicateGroup"
+                    }
+                },
+                "nameGroups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DuplicateGroup"
+                    }
+                }
+            }
+        },
+        "main.Error": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.Itinerary": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "legs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.ItineraryLeg"
+                    }
+                },
+                "transfers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.ItineraryLeg": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "from": {
+                    "$ref": "#/definitions/main.RoutePoint"
+                },
+                "line": {
+                    "type": "string"
+                },
+                "mode": {
+                    "$ref": "#/definitions/main.TransportMode"
+                },
+                "stops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Warp"
+                    }
+                },
+                "to": {
+                    "$ref": "#/definitions/main.RoutePoint"
+                },
+                "type": {
+                    "$ref": "#/definitions/main.RouteEdgeType"
+                }
+            }
+        },
+        "main.Line": {
+            "type": "object",
+            "properties": {
+                "colour": {
+                    "type": "string"
+                },
+                "company": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stops": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "world": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.LineDetail": {
+            "type": "object",
+            "properties": {
+                "colour": {
+                    "type": "string"
+                },
+                "company": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "missingStops": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "resolvedStops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.LineStop"
+                    }
+                },
+                "stops": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "world": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.LineStop": {
+            "type": "object",
+            "properties": {
+                "missing": {
+                    "type": "boolean"
+                },
+                "stop": {
                     "type": "string"
                 },
-                "mode": {
+                "warps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Warp"
+                    }
+                }
+            }
+        },
+        "main.Mode": {
+            "type": "object",
+            "properties": {
+                "colour": {
+                    "type": "string"
+                },
+                "icon": {
+                    "$ref": "#/definitions/main.ModeIcon"
+                },
+                "id": {
                     "$ref": "#/definitions/main.TransportMode"
                 },
                 "name": {
                     "type": "string"
                 },
-                "pattern": {
+                "order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.ModeIcon": {
+            "type": "object",
+            "properties": {
+                "bluemap": {
+                    "description": "Path of an image relative to the BlueMap webroot",
+                    "type": "string"
+                },
+                "dynmap": {
+                    "description": "ID of a built-in Dynmap marker icon",
                     "type": "string"
                 }
             }
         },
-        "main.Error": {
+        "main.RouteEdgeType": {
+            "type": "string",
+            "enum": [
+                "ride",
+                "walk"
+            ],
+            "x-enum-varnames": [
+                "RideEdge",
+                "WalkEdge"
+            ]
+        },
+        "main.RoutePoint": {
             "type": "object",
             "properties": {
-                "detail": {
+                "warp": {
+                    "$ref": "#/definitions/main.Warp"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.RouteResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "$ref": "#/definitions/main.RoutePoint"
+                },
+                "itineraries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Itinerary"
+                    }
+                },
+                "to": {
+                    "$ref": "#/definitions/main.RoutePoint"
+                },
+                "world": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.Station": {
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
                     "type": "string"
                 },
-                "error": {
+                "modes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.TransportMode"
+                    }
+                },
+                "name": {
                     "type": "string"
                 },
-                "message": {
+                "warpIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "world": {
+                    "type": "string"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.StationDetail": {
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "modes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.TransportMode"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "warpIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "warps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.Warp"
+                    }
+                },
+                "world": {
                     "type": "string"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
                 }
             }
         },
         "main.TransportMode": {
             "type": "string",
             "enum": [
-                "warp_rail",
-                "bus",
-                "air",
-                "sea",
                 "other"
             ],
             "x-enum-varnames": [
-                "WarpRail",
-                "Bus",
-                "Air",
-                "Sea",
                 "Other"
             ]
         },
         "main.Warp": {
             "type": "object",
             "properties": {
+                "areas": {
+                    "description": "IDs of the areas (such as cities) that the warp lies in",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "chunkX": {
+                    "description": "Chunk and region file that contain the warp in its own world, calculated from its original coordinates (before any 'coords' conversion)",
+                    "type": "integer"
+                },
+                "chunkZ": {
+                    "type": "integer"
+                },
                 "creationDate": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "mapUrl": {
+                    "description": "Location of the warp on the web map of its world, if the world has a map URL template",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -336,6 +2998,12 @@ const docTemplate = `{
                 "playerUUID": {
                     "type": "string"
                 },
+                "regionX": {
+                    "type": "integer"
+                },
+                "regionZ": {
+                    "type": "integer"
+                },
                 "type": {
                     "type": "integer"
                 },
@@ -393,18 +3061,196 @@ const docTemplate = `{
                 }
             }
         },
-        "main.World": {
+        "main.WarpWithDistance": {
+            "type": "object",
+            "properties": {
+                "areas": {
+                    "description": "IDs of the areas (such as cities) that the warp lies in",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "chunkX": {
+                    "description": "Chunk and region file that contain the warp in its own world, calculated from its original coordinates (before any 'coords' conversion)",
+                    "type": "integer"
+                },
+                "chunkZ": {
+                    "type": "integer"
+                },
+                "creationDate": {
+                    "type": "string"
+                },
+                "distance": {
+                    "description": "Horizontal distance (in blocks) from the corresponding location",
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mapUrl": {
+                    "description": "Location of the warp on the web map of its world, if the world has a map URL template",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pitch": {
+                    "type": "number"
+                },
+                "playerUUID": {
+                    "type": "string"
+                },
+                "regionX": {
+                    "type": "integer"
+                },
+                "regionZ": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "integer"
+                },
+                "visits": {
+                    "type": "integer"
+                },
+                "welcomeMessage": {
+                    "type": "string"
+                },
+                "worldUUID": {
+                    "type": "string"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                },
+                "yaw": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.WorldBorder": {
+            "type": "object",
+            "properties": {
+                "centerX": {
+                    "type": "number"
+                },
+                "centerZ": {
+                    "type": "number"
+                },
+                "size": {
+                    "description": "Length of each side of the border, in blocks",
+                    "type": "number"
+                }
+            }
+        },
+        "main.WorldDimension": {
+            "type": "string",
+            "enum": [
+                "overworld",
+                "nether",
+                "end"
+            ],
+            "x-enum-varnames": [
+                "Overworld",
+                "Nether",
+                "End"
+            ]
+        },
+        "main.WorldPoint": {
+            "type": "object",
+            "properties": {
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.WorldRegion": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name of the region file, e.g. 'r.-1.2.mca'",
+                    "type": "string"
+                },
+                "regionX": {
+                    "type": "integer"
+                },
+                "regionZ": {
+                    "type": "integer"
+                },
+                "warpCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.WorldWithWarpCount": {
             "type": "object",
             "properties": {
+                "border": {
+                    "$ref": "#/definitions/main.WorldBorder"
+                },
+                "dimension": {
+                    "description": "Dimension of the world, which is the overworld if not specified",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/main.WorldDimension"
+                        }
+                    ]
+                },
                 "id": {
                     "type": "string"
                 },
+                "mapUrl": {
+                    "description": "Template of a URL that shows a location on the world's web map, with the placeholders {x}, {y} and {z}",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Display name of the world, which is the same as the ID if not specified",
+                    "type": "string"
+                },
+                "pair": {
+                    "description": "ID of the world in the other dimension that is linked to this world by nether portals (an overworld and a nether)",
+                    "type": "string"
+                },
+                "registered": {
+                    "description": "False if the world is in the MyWarp database but not defined in worlds.yml, in which case only its UUID is known",
+                    "type": "boolean"
+                },
+                "spawn": {
+                    "description": "Optional metadata",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/main.WorldPoint"
+                        }
+                    ]
+                },
                 "uuid": {
                     "type": "string"
+                },
+                "warpCount": {
+                    "type": "integer"
                 }
             }
         }
     },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Admin token from config/admin_config.yml, in the format 'Bearer \u003ctoken\u003e'.",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
+    },
     "externalDocs": {
         "description": "GitHub Repository",
         "url": "https://github.com/Frumple/mrt-api"
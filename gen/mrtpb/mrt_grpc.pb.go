@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
+// source: mrt/v1/mrt.proto
+
+package mrtpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MrtService_ListWarps_FullMethodName     = "/mrt.v1.MrtService/ListWarps"
+	MrtService_StreamWarps_FullMethodName   = "/mrt.v1.MrtService/StreamWarps"
+	MrtService_GetWarp_FullMethodName       = "/mrt.v1.MrtService/GetWarp"
+	MrtService_ListCompanies_FullMethodName = "/mrt.v1.MrtService/ListCompanies"
+	MrtService_ListWorlds_FullMethodName    = "/mrt.v1.MrtService/ListWorlds"
+	MrtService_ListModes_FullMethodName     = "/mrt.v1.MrtService/ListModes"
+	MrtService_ListAlliances_FullMethodName = "/mrt.v1.MrtService/ListAlliances"
+	MrtService_ListAreas_FullMethodName     = "/mrt.v1.MrtService/ListAreas"
+)
+
+// MrtServiceClient is the client API for MrtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MrtServiceClient interface {
+	// List a single page of warps. Maximum number of warps returned per request is 2000.
+	ListWarps(ctx context.Context, in *ListWarpsRequest, opts ...grpc.CallOption) (*ListWarpsResponse, error)
+	// Stream every warp matching the filter, without any limit on the number of warps returned.
+	StreamWarps(ctx context.Context, in *StreamWarpsRequest, opts ...grpc.CallOption) (MrtService_StreamWarpsClient, error)
+	// Get warp by ID.
+	GetWarp(ctx context.Context, in *GetWarpRequest, opts ...grpc.CallOption) (*Warp, error)
+	// List all companies (defined in data/companies.yml).
+	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error)
+	// List all worlds (defined in data/worlds.yml), with the number of warps in each world.
+	ListWorlds(ctx context.Context, in *ListWorldsRequest, opts ...grpc.CallOption) (*ListWorldsResponse, error)
+	// List all transport modes (defined in data/modes.yml).
+	ListModes(ctx context.Context, in *ListModesRequest, opts ...grpc.CallOption) (*ListModesResponse, error)
+	// List all alliances of companies (defined in data/alliances.yml).
+	ListAlliances(ctx context.Context, in *ListAlliancesRequest, opts ...grpc.CallOption) (*ListAlliancesResponse, error)
+	// List all areas, such as cities (defined in data/areas.yml).
+	ListAreas(ctx context.Context, in *ListAreasRequest, opts ...grpc.CallOption) (*ListAreasResponse, error)
+}
+
+type mrtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMrtServiceClient(cc grpc.ClientConnInterface) MrtServiceClient {
+	return &mrtServiceClient{cc}
+}
+
+func (c *mrtServiceClient) ListWarps(ctx context.Context, in *ListWarpsRequest, opts ...grpc.CallOption) (*ListWarpsResponse, error) {
+	out := new(ListWarpsResponse)
+	err := c.cc.Invoke(ctx, MrtService_ListWarps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mrtServiceClient) StreamWarps(ctx context.Context, in *StreamWarpsRequest, opts ...grpc.CallOption) (MrtService_StreamWarpsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MrtService_ServiceDesc.Streams[0], MrtService_StreamWarps_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mrtServiceStreamWarpsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MrtService_StreamWarpsClient interface {
+	Recv() (*Warp, error)
+	grpc.ClientStream
+}
+
+type mrtServiceStreamWarpsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mrtServiceStreamWarpsClient) Recv() (*Warp, error) {
+	m := new(Warp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mrtServiceClient) GetWarp(ctx context.Context, in *GetWarpRequest, opts ...grpc.CallOption) (*Warp, error) {
+	out := new(Warp)
+	err := c.cc.Invoke(ctx, MrtService_GetWarp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mrtServiceClient) ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error) {
+	out := new(ListCompaniesResponse)
+	err := c.cc.Invoke(ctx, MrtService_ListCompanies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mrtServiceClient) ListWorlds(ctx context.Context, in *ListWorldsRequest, opts ...grpc.CallOption) (*ListWorldsResponse, error) {
+	out := new(ListWorldsResponse)
+	err := c.cc.Invoke(ctx, MrtService_ListWorlds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mrtServiceClient) ListModes(ctx context.Context, in *ListModesRequest, opts ...grpc.CallOption) (*ListModesResponse, error) {
+	out := new(ListModesResponse)
+	err := c.cc.Invoke(ctx, MrtService_ListModes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mrtServiceClient) ListAlliances(ctx context.Context, in *ListAlliancesRequest, opts ...grpc.CallOption) (*ListAlliancesResponse, error) {
+	out := new(ListAlliancesResponse)
+	err := c.cc.Invoke(ctx, MrtService_ListAlliances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mrtServiceClient) ListAreas(ctx context.Context, in *ListAreasRequest, opts ...grpc.CallOption) (*ListAreasResponse, error) {
+	out := new(ListAreasResponse)
+	err := c.cc.Invoke(ctx, MrtService_ListAreas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MrtServiceServer is the server API for MrtService service.
+// All implementations must embed UnimplementedMrtServiceServer
+// for forward compatibility
+type MrtServiceServer interface {
+	// List a single page of warps. Maximum number of warps returned per request is 2000.
+	ListWarps(context.Context, *ListWarpsRequest) (*ListWarpsResponse, error)
+	// Stream every warp matching the filter, without any limit on the number of warps returned.
+	StreamWarps(*StreamWarpsRequest, MrtService_StreamWarpsServer) error
+	// Get warp by ID.
+	GetWarp(context.Context, *GetWarpRequest) (*Warp, error)
+	// List all companies (defined in data/companies.yml).
+	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error)
+	// List all worlds (defined in data/worlds.yml), with the number of warps in each world.
+	ListWorlds(context.Context, *ListWorldsRequest) (*ListWorldsResponse, error)
+	// List all transport modes (defined in data/modes.yml).
+	ListModes(context.Context, *ListModesRequest) (*ListModesResponse, error)
+	// List all alliances of companies (defined in data/alliances.yml).
+	ListAlliances(context.Context, *ListAlliancesRequest) (*ListAlliancesResponse, error)
+	// List all areas, such as cities (defined in data/areas.yml).
+	ListAreas(context.Context, *ListAreasRequest) (*ListAreasResponse, error)
+	mustEmbedUnimplementedMrtServiceServer()
+}
+
+// UnimplementedMrtServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMrtServiceServer struct {
+}
+
+func (UnimplementedMrtServiceServer) ListWarps(context.Context, *ListWarpsRequest) (*ListWarpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarps not implemented")
+}
+func (UnimplementedMrtServiceServer) StreamWarps(*StreamWarpsRequest, MrtService_StreamWarpsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWarps not implemented")
+}
+func (UnimplementedMrtServiceServer) GetWarp(context.Context, *GetWarpRequest) (*Warp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarp not implemented")
+}
+func (UnimplementedMrtServiceServer) ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompanies not implemented")
+}
+func (UnimplementedMrtServiceServer) ListWorlds(context.Context, *ListWorldsRequest) (*ListWorldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorlds not implemented")
+}
+func (UnimplementedMrtServiceServer) ListModes(context.Context, *ListModesRequest) (*ListModesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModes not implemented")
+}
+func (UnimplementedMrtServiceServer) ListAlliances(context.Context, *ListAlliancesRequest) (*ListAlliancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlliances not implemented")
+}
+func (UnimplementedMrtServiceServer) ListAreas(context.Context, *ListAreasRequest) (*ListAreasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAreas not implemented")
+}
+func (UnimplementedMrtServiceServer) mustEmbedUnimplementedMrtServiceServer() {}
+
+// UnsafeMrtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MrtServiceServer will
+// result in compilation errors.
+type UnsafeMrtServiceServer interface {
+	mustEmbedUnimplementedMrtServiceServer()
+}
+
+func RegisterMrtServiceServer(s grpc.ServiceRegistrar, srv MrtServiceServer) {
+	s.RegisterService(&MrtService_ServiceDesc, srv)
+}
+
+func _MrtService_ListWarps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MrtServiceServer).ListWarps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MrtService_ListWarps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MrtServiceServer).ListWarps(ctx, req.(*ListWarpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MrtService_StreamWarps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWarpsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MrtServiceServer).StreamWarps(m, &mrtServiceStreamWarpsServer{stream})
+}
+
+type MrtService_StreamWarpsServer interface {
+	Send(*Warp) error
+	grpc.ServerStream
+}
+
+type mrtServiceStreamWarpsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mrtServiceStreamWarpsServer) Send(m *Warp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MrtService_GetWarp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWarpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MrtServiceServer).GetWarp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MrtService_GetWarp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MrtServiceServer).GetWarp(ctx, req.(*GetWarpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MrtService_ListCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompaniesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MrtServiceServer).ListCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MrtService_ListCompanies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MrtServiceServer).ListCompanies(ctx, req.(*ListCompaniesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MrtService_ListWorlds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MrtServiceServer).ListWorlds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MrtService_ListWorlds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MrtServiceServer).ListWorlds(ctx, req.(*ListWorldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MrtService_ListModes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MrtServiceServer).ListModes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MrtService_ListModes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MrtServiceServer).ListModes(ctx, req.(*ListModesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MrtService_ListAlliances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlliancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MrtServiceServer).ListAlliances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MrtService_ListAlliances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MrtServiceServer).ListAlliances(ctx, req.(*ListAlliancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MrtService_ListAreas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAreasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MrtServiceServer).ListAreas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MrtService_ListAreas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MrtServiceServer).ListAreas(ctx, req.(*ListAreasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MrtService_ServiceDesc is the grpc.ServiceDesc for MrtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MrtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mrt.v1.MrtService",
+	HandlerType: (*MrtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListWarps",
+			Handler:    _MrtService_ListWarps_Handler,
+		},
+		{
+			MethodName: "GetWarp",
+			Handler:    _MrtService_GetWarp_Handler,
+		},
+		{
+			MethodName: "ListCompanies",
+			Handler:    _MrtService_ListCompanies_Handler,
+		},
+		{
+			MethodName: "ListWorlds",
+			Handler:    _MrtService_ListWorlds_Handler,
+		},
+		{
+			MethodName: "ListModes",
+			Handler:    _MrtService_ListModes_Handler,
+		},
+		{
+			MethodName: "ListAlliances",
+			Handler:    _MrtService_ListAlliances_Handler,
+		},
+		{
+			MethodName: "ListAreas",
+			Handler:    _MrtService_ListAreas_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWarps",
+			Handler:       _MrtService_StreamWarps_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "mrt/v1/mrt.proto",
+}
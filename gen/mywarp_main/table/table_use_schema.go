@@ -11,6 +11,8 @@ package table
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
 	Group = Group.FromSchema(schema)
+	MrtCompany = MrtCompany.FromSchema(schema)
+	MrtCompanyAudit = MrtCompanyAudit.FromSchema(schema)
 	Player = Player.FromSchema(schema)
 	SchemaVersion = SchemaVersion.FromSchema(schema)
 	Warp = Warp.FromSchema(schema)
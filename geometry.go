@@ -0,0 +1,167 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// Horizontal position (x, z) of a warp or other location
+type Point struct {
+	X float64
+	Z float64
+}
+
+func (point Point) distance(other Point) float64 {
+	return math.Hypot(point.X-other.X, point.Z-other.Z)
+}
+
+// findNearbyPairs returns the indices of all pairs of points that are within the distance of each other.
+// Points are bucketed into a grid of cells the size of the distance, so that only neighbouring cells need to be compared.
+func findNearbyPairs(points []Point, distance float64) [][2]int {
+	pairs := [][2]int{}
+	if distance <= 0 {
+		return pairs
+	}
+
+	cells := map[[2]int][]int{}
+	for index, point := range points {
+		cell := [2]int{int(math.Floor(point.X / distance)), int(math.Floor(point.Z / distance))}
+		cells[cell] = append(cells[cell], index)
+	}
+
+	for cell, members := range cells {
+		for dx := -1; dx <= 1; dx++ {
+			for dz := -1; dz <= 1; dz++ {
+				neighbours, exists := cells[[2]int{cell[0] + dx, cell[1] + dz}]
+				if !exists {
+					continue
+				}
+
+				for _, a := range members {
+					for _, b := range neighbours {
+						// Only include each pair once
+						if a >= b {
+							continue
+						}
+
+						if points[a].distance(points[b]) <= distance {
+							pairs = append(pairs, [2]int{a, b})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return pairs
+}
+
+// clusterPoints groups points into clusters, where every point is within the distance of at least one other point in the same cluster,
+// and no two points in the same cluster are further apart than the diameter. This stops clusters from chaining through dense areas.
+// Nearby points are merged closest first, so the result does not depend on the order of the points.
+// Clusters are returned in order of their lowest index, with the indices in each cluster in ascending order.
+func clusterPoints(points []Point, distance float64, diameter float64) [][]int {
+	pairs := findNearbyPairs(points, distance)
+
+	sort.Slice(pairs, func(i, j int) bool {
+		a, b := pairs[i], pairs[j]
+		distanceA := points[a[0]].distance(points[a[1]])
+		distanceB := points[b[0]].distance(points[b[1]])
+
+		if distanceA != distanceB {
+			return distanceA < distanceB
+		}
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		return a[1] < b[1]
+	})
+
+	// Each point starts in its own cluster, which is identified by its lowest index
+	clusterIDs := make([]int, len(points))
+	members := make([][]int, len(points))
+	for i := range points {
+		clusterIDs[i] = i
+		members[i] = []int{i}
+	}
+
+	mergedPairs := [][2]int{}
+
+	for _, pair := range pairs {
+		a, b := clusterIDs[pair[0]], clusterIDs[pair[1]]
+		if a == b || !withinDiameter(points, members[a], members[b], diameter) {
+			continue
+		}
+
+		if b < a {
+			a, b = b, a
+		}
+
+		for _, i := range members[b] {
+			clusterIDs[i] = a
+		}
+
+		members[a] = append(members[a], members[b]...)
+		members[b] = nil
+		mergedPairs = append(mergedPairs, [2]int{a, b})
+	}
+
+	return clusterPairs(len(points), mergedPairs)
+}
+
+// withinDiameter returns true if every point of the first cluster is within the diameter of every point of the second cluster.
+func withinDiameter(points []Point, first []int, second []int, diameter float64) bool {
+	for _, a := range first {
+		for _, b := range second {
+			if points[a].distance(points[b]) > diameter {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// clusterPairs groups the indices 0 to count-1 into clusters, where both indices of each pair are in the same cluster.
+// Clusters are returned in order of their lowest index, with the indices in each cluster in ascending order.
+func clusterPairs(count int, pairs [][2]int) [][]int {
+	parents := make([]int, count)
+	for i := range parents {
+		parents[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+
+	for _, pair := range pairs {
+		a, b := find(pair[0]), find(pair[1])
+		if a < b {
+			parents[b] = a
+		} else if b < a {
+			parents[a] = b
+		}
+	}
+
+	clusters := [][]int{}
+	clustersByRoot := map[int]int{}
+
+	for i := 0; i < count; i++ {
+		root := find(i)
+
+		index, exists := clustersByRoot[root]
+		if !exists {
+			index = len(clusters)
+			clustersByRoot[root] = index
+			clusters = append(clusters, []int{})
+		}
+
+		clusters[index] = append(clusters[index], i)
+	}
+
+	return clusters
+}
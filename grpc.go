@@ -0,0 +1,350 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"strconv"
+
+	"github.com/frumple/mrt-api/gen/mrtpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcServer struct {
+	mrtpb.UnimplementedMrtServiceServer
+
+	warpProvider WarpProviderV2
+}
+
+func (server GrpcServer) ListWarps(ctx context.Context, request *mrtpb.ListWarpsRequest) (*mrtpb.ListWarpsResponse, error) {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	parameters := warpFilterToParameters(request.GetFilter())
+	parameters.OrderBy = request.GetOrderBy()
+	parameters.SortBy = request.GetSortBy()
+	if request.Limit != nil {
+		parameters.Limit = strconv.FormatUint(uint64(request.GetLimit()), 10)
+	}
+	parameters.Offset = strconv.FormatUint(uint64(request.GetOffset()), 10)
+
+	condition, err := server.warpProvider.buildWarpCondition(parameters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orderByClause, err := buildWarpOrderByClause(parameters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit, offset, err := buildWarpPagination(parameters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response, err := server.warpProvider.queryWarps(ctx, condition, orderByClause, limit, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := []*mrtpb.Warp{}
+	for _, warp := range response.Result {
+		result = append(result, warpToProto(warp))
+	}
+
+	pagination := response.Pagination
+	return &mrtpb.ListWarpsResponse{
+		Pagination: &mrtpb.Pagination{
+			Limit:     uint32(pagination.Limit),
+			Offset:    uint32(pagination.Offset),
+			Hits:      uint32(pagination.Hits),
+			TotalHits: uint32(pagination.TotalHits),
+		},
+		Result: result,
+	}, nil
+}
+
+func (server GrpcServer) StreamWarps(request *mrtpb.StreamWarpsRequest, stream mrtpb.MrtService_StreamWarpsServer) error {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	parameters := warpFilterToParameters(request.GetFilter())
+	parameters.OrderBy = request.GetOrderBy()
+	parameters.SortBy = request.GetSortBy()
+
+	condition, err := server.warpProvider.buildWarpCondition(parameters)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orderByClause, err := buildWarpOrderByClause(parameters)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = server.warpProvider.streamWarps(stream.Context(), condition, orderByClause, func(warp Warp) error {
+		return stream.Send(warpToProto(warp))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (server GrpcServer) GetWarp(ctx context.Context, request *mrtpb.GetWarpRequest) (*mrtpb.Warp, error) {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	warp, exists, err := server.warpProvider.queryWarpById(ctx, request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !exists {
+		return nil, status.Error(codes.NotFound, ErrorNotFound.Message)
+	}
+
+	return warpToProto(warp), nil
+}
+
+func (server GrpcServer) ListCompanies(ctx context.Context, request *mrtpb.ListCompaniesRequest) (*mrtpb.ListCompaniesResponse, error) {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	companies, err := server.warpProvider.companyProvider().listCompanies(request.GetMode(), request.GetOwner())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result := []*mrtpb.Company{}
+	for _, company := range companies {
+		result = append(result, &mrtpb.Company{
+			Id:          company.ID,
+			Name:        company.Name,
+			Pattern:     company.Pattern,
+			Mode:        string(company.Mode),
+			Colour:      company.Colour,
+			Description: company.Description,
+			Website:     company.Website,
+			Owners:      company.Owners,
+			Founded:     company.Founded,
+			Logo:        company.Logo,
+			Predecessor: company.Predecessor,
+			Successor:   company.Successor,
+			ActiveFrom:  company.ActiveFrom,
+			ActiveTo:    company.ActiveTo,
+			Parent:      company.Parent,
+			Alliances:   company.Alliances,
+			Regex:       company.Regex,
+		})
+	}
+
+	return &mrtpb.ListCompaniesResponse{Companies: result}, nil
+}
+
+func (server GrpcServer) ListWorlds(ctx context.Context, request *mrtpb.ListWorldsRequest) (*mrtpb.ListWorldsResponse, error) {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	worlds, err := server.warpProvider.listWorldsWithWarpCounts(ctx, request.GetIncludeUnregistered())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := []*mrtpb.World{}
+	for _, world := range worlds {
+		protoWorld := &mrtpb.World{
+			Id:         world.ID,
+			Uuid:       world.UUID,
+			Name:       world.Name,
+			Dimension:  string(world.Dimension),
+			MapUrl:     world.MapURL,
+			Pair:       world.Pair,
+			Registered: world.Registered,
+			WarpCount:  uint32(world.WarpCount),
+		}
+
+		if world.Spawn != nil {
+			protoWorld.Spawn = &mrtpb.WorldPoint{X: world.Spawn.X, Y: world.Spawn.Y, Z: world.Spawn.Z}
+		}
+
+		if world.Border != nil {
+			protoWorld.Border = &mrtpb.WorldBorder{CenterX: world.Border.CenterX, CenterZ: world.Border.CenterZ, Size: world.Border.Size}
+		}
+
+		result = append(result, protoWorld)
+	}
+
+	return &mrtpb.ListWorldsResponse{Worlds: result}, nil
+}
+
+func (server GrpcServer) ListModes(ctx context.Context, request *mrtpb.ListModesRequest) (*mrtpb.ListModesResponse, error) {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	result := []*mrtpb.Mode{}
+	for _, mode := range server.warpProvider.modeProvider().modes {
+		result = append(result, &mrtpb.Mode{
+			Id:          string(mode.ID),
+			Name:        mode.Name,
+			Colour:      mode.Colour,
+			DynmapIcon:  mode.Icon.Dynmap,
+			BluemapIcon: mode.Icon.BlueMap,
+			Order:       int32(mode.Order),
+		})
+	}
+
+	return &mrtpb.ListModesResponse{Modes: result}, nil
+}
+
+func (server GrpcServer) ListAlliances(ctx context.Context, request *mrtpb.ListAlliancesRequest) (*mrtpb.ListAlliancesResponse, error) {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	allianceProvider := server.warpProvider.allianceProvider()
+
+	result := []*mrtpb.Alliance{}
+	for _, alliance := range allianceProvider.alliances {
+		companyIDs := []string{}
+		for _, company := range allianceProvider.companiesByAlliance[alliance.ID] {
+			companyIDs = append(companyIDs, company.ID)
+		}
+
+		result = append(result, &mrtpb.Alliance{
+			Id:          alliance.ID,
+			Name:        alliance.Name,
+			Description: alliance.Description,
+			Website:     alliance.Website,
+			Companies:   companyIDs,
+		})
+	}
+
+	return &mrtpb.ListAlliancesResponse{Alliances: result}, nil
+}
+
+func (server GrpcServer) ListAreas(ctx context.Context, request *mrtpb.ListAreasRequest) (*mrtpb.ListAreasResponse, error) {
+	server.warpProvider = server.warpProvider.withSnapshot()
+
+	result := []*mrtpb.Area{}
+	for _, area := range server.warpProvider.areaProvider().areas {
+		polygon := []*mrtpb.AreaPoint{}
+		for _, point := range area.Polygon {
+			polygon = append(polygon, &mrtpb.AreaPoint{X: point[0], Z: point[1]})
+		}
+
+		result = append(result, &mrtpb.Area{
+			Id:          area.ID,
+			Name:        area.Name,
+			World:       area.World,
+			Polygon:     polygon,
+			Description: area.Description,
+		})
+	}
+
+	return &mrtpb.ListAreasResponse{Areas: result}, nil
+}
+
+func warpFilterToParameters(filter *mrtpb.WarpFilter) WarpQueryParameters {
+	parameters := WarpQueryParameters{
+		Name:          filter.GetName(),
+		PlayerUUID:    filter.GetPlayer(),
+		CompanyID:     filter.GetCompany(),
+		Mode:          filter.GetMode(),
+		WorldID:       filter.GetWorld(),
+		IncludeLegacy: strconv.FormatBool(filter.GetIncludeLegacy()),
+		AllianceID:    filter.GetAlliance(),
+		Region:        filter.GetRegion(),
+		Chunk:         filter.GetChunk(),
+		AreaID:        filter.GetArea(),
+	}
+
+	if filter.Type != nil {
+		parameters.Type = strconv.FormatUint(uint64(filter.GetType()), 10)
+	}
+
+	return parameters
+}
+
+func warpToProto(warp Warp) *mrtpb.Warp {
+	return &mrtpb.Warp{
+		Id:             warp.ID,
+		Name:           warp.Name,
+		PlayerUuid:     warp.PlayerUUID,
+		WorldUuid:      warp.WorldUUID,
+		X:              warp.X,
+		Y:              warp.Y,
+		Z:              warp.Z,
+		Pitch:          warp.Pitch,
+		Yaw:            warp.Yaw,
+		CreationDate:   timestamppb.New(warp.CreationDate),
+		Type:           uint32(warp.Type),
+		Visits:         warp.Visits,
+		WelcomeMessage: warp.WelcomeMessage,
+		MapUrl:         warp.MapURL,
+		ChunkX:         int32(warp.ChunkX),
+		ChunkZ:         int32(warp.ChunkZ),
+		RegionX:        int32(warp.RegionX),
+		RegionZ:        int32(warp.RegionZ),
+		Areas:          warp.Areas,
+	}
+}
+
+// Limits the number of requests that are processed at once, in the same way as the Throttle middleware of the HTTP API.
+// Requests beyond the limit are rejected immediately rather than queued.
+type GrpcThrottle struct {
+	tokens chan struct{}
+}
+
+func newGrpcThrottle(limit int) GrpcThrottle {
+	return GrpcThrottle{tokens: make(chan struct{}, limit)}
+}
+
+func (throttle GrpcThrottle) acquire() bool {
+	select {
+	case throttle.tokens <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (throttle GrpcThrottle) release() {
+	<-throttle.tokens
+}
+
+func (throttle GrpcThrottle) unaryInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !throttle.acquire() {
+		return nil, status.Error(codes.ResourceExhausted, "Server capacity exceeded.")
+	}
+	defer throttle.release()
+
+	return handler(ctx, request)
+}
+
+// streamInterceptor holds a slot for the whole duration of the stream, since streams such as StreamWarps are the most expensive requests.
+func (throttle GrpcThrottle) streamInterceptor(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !throttle.acquire() {
+		return status.Error(codes.ResourceExhausted, "Server capacity exceeded.")
+	}
+	defer throttle.release()
+
+	return handler(server, stream)
+}
+
+func serveGrpc(addr string, warpProvider WarpProviderV2) {
+	listener, err := net.Listen("tcp", addr)
+	checkForErrors(err)
+
+	throttle := newGrpcThrottle(MAX_THROTTLE)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(throttle.unaryInterceptor),
+		grpc.StreamInterceptor(throttle.streamInterceptor),
+	)
+	mrtpb.RegisterMrtServiceServer(server, GrpcServer{warpProvider: warpProvider})
+	reflection.Register(server)
+
+	log.Println("gRPC server started on: ", addr)
+	log.Fatal(server.Serve(listener))
+}
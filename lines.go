@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+type Line struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Company string   `json:"company"`
+	Colour  string   `json:"colour"`
+	World   string   `json:"world,omitempty"`
+	Stops   []string `json:"stops"`
+
+	// Equivalent of each stop for matching warp names in memory instead of in the database
+	stopRegexps []*regexp.Regexp
+}
+
+func (line Line) GetID() string {
+	return line.ID
+}
+
+func (line Line) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// A stop containing "%" is a LIKE pattern, otherwise it is an exact warp name.
+func isStopPattern(stop string) bool {
+	return strings.Contains(stop, "%")
+}
+
+func stopToRegexp(stop string) (*regexp.Regexp, error) {
+	if isStopPattern(stop) {
+		return likePatternToRegexp(stop)
+	}
+
+	return regexp.Compile("(?i)^" + regexp.QuoteMeta(stop) + "$")
+}
+
+func stopToBoolExpression(stop string) BoolExpression {
+	if isStopPattern(stop) {
+		return table.Warp.Name.LIKE(String(stop))
+	}
+
+	return table.Warp.Name.EQ(String(stop))
+}
+
+type LineDetail struct {
+	Line
+	ResolvedStops []LineStop `json:"resolvedStops"`
+	MissingStops  int        `json:"missingStops"`
+}
+
+func (detail LineDetail) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type LineStop struct {
+	Stop    string `json:"stop"`
+	Missing bool   `json:"missing"`
+	Warps   []Warp `json:"warps"`
+}
+
+type LineProvider struct {
+	lines            []Line
+	linesByID        *orderedmap.OrderedMap[string, Line]
+	linesByCompanyID *orderedmap.OrderedMap[string, []Line]
+
+	warpProvider WarpProviderV2
+}
+
+// getLines     godoc
+// @summary     List all lines
+// @description List all lines (defined in https://github.com/Frumple/mrt-api/blob/main/data/lines.yml).
+// @tags        Lines
+// @produce     json
+// @param       company query   string false "Filter by company ID (from /companies)."
+// @success     200     {array} Line
+// @failure     400     {object} Error
+// @router      /lines [get]
+func (provider LineProvider) getLines(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	companyID := request.URL.Query().Get("company")
+
+	lines := provider.lines
+
+	if companyID != "" {
+		if _, exists := provider.warpProvider.companyProvider().companiesByID.Get(companyID); !exists {
+			detail := "The 'company' query parameter must be one of the IDs returned from the /companies endpoint."
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		lines = provider.getLinesOfCompany(companyID)
+	}
+
+	err := render.RenderList(writer, request, toRenderList(lines))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getLineById  godoc
+// @summary     Get line by ID
+// @description Get line by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/lines.yml), including the warps that match each stop.
+// @description Stops that do not match any warps are flagged as missing.
+// @tags        Lines
+// @produce     json
+// @param       id  path     string true "Line ID"
+// @success     200 {object} LineDetail
+// @failure     404 {object} Error
+// @router      /lines/{id} [get]
+func (provider LineProvider) getLineById(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+
+	line, exists := provider.linesByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	detail, err := provider.resolveLine(request.Context(), line)
+	checkForErrors(err)
+
+	err = render.Render(writer, request, detail)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getCompanyLines godoc
+// @summary     List lines of company
+// @description List all lines operated by a company (defined in https://github.com/Frumple/mrt-api/blob/main/data/lines.yml).
+// @tags        Companies
+// @produce     json
+// @param       id  path    string true "Company ID"
+// @success     200 {array} Line
+// @failure     404 {object} Error
+// @router      /companies/{id}/lines [get]
+func (provider LineProvider) getCompanyLines(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+
+	if _, exists := provider.warpProvider.companyProvider().companiesByID.Get(id); !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	err := render.RenderList(writer, request, toRenderList(provider.getLinesOfCompany(id)))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getLinesOfCompany returns the lines of the company, which is empty for companies added after the lines were loaded.
+func (provider LineProvider) getLinesOfCompany(companyID string) []Line {
+	lines, exists := provider.linesByCompanyID.Get(companyID)
+	if !exists {
+		return []Line{}
+	}
+
+	return lines
+}
+
+// resolveLine looks up the warps that match each stop of the line.
+func (provider LineProvider) resolveLine(ctx context.Context, line Line) (LineDetail, error) {
+	detail := LineDetail{
+		Line:          line,
+		ResolvedStops: []LineStop{},
+	}
+
+	warps := []Warp{}
+
+	if len(line.Stops) > 0 {
+		orExpressions := []BoolExpression{}
+		for _, stop := range line.Stops {
+			orExpressions = append(orExpressions, stopToBoolExpression(stop))
+		}
+
+		condition := OR(orExpressions...)
+
+		if line.World != "" {
+			world, _ := provider.warpProvider.worldProvider().worldsByID.Get(line.World)
+			condition = AND(table.World.UUID.EQ(String(world.UUID)), condition)
+		}
+
+		err := provider.warpProvider.streamWarps(ctx, condition, table.Warp.WarpID.ASC(), func(warp Warp) error {
+			warps = append(warps, warp)
+			return nil
+		})
+		if err != nil {
+			return LineDetail{}, err
+		}
+	}
+
+	for _, stop := range line.matchStops(warps) {
+		detail.ResolvedStops = append(detail.ResolvedStops, stop)
+		if stop.Missing {
+			detail.MissingStops++
+		}
+	}
+
+	return detail, nil
+}
+
+// matchStops assigns each of the warps to the stops whose name or pattern they match.
+func (line Line) matchStops(warps []Warp) []LineStop {
+	stops := []LineStop{}
+
+	for i, stop := range line.Stops {
+		matched := []Warp{}
+		for _, warp := range warps {
+			if line.stopRegexps[i].MatchString(warp.Name) {
+				matched = append(matched, warp)
+			}
+		}
+
+		stops = append(stops, LineStop{
+			Stop:    stop,
+			Missing: len(matched) == 0,
+			Warps:   matched,
+		})
+	}
+
+	return stops
+}
+
+// toRouteLine converts the line into a route line, using the first matching warp of each stop and skipping missing stops.
+func (line Line) toRouteLine(company Company, warps []Warp) RouteLine {
+	routeLine := RouteLine{
+		ID:      line.ID,
+		Company: company,
+		Warps:   []Warp{},
+	}
+
+	for _, stop := range line.matchStops(warps) {
+		if !stop.Missing {
+			routeLine.Warps = append(routeLine.Warps, stop.Warps[0])
+		}
+	}
+
+	return routeLine
+}
+
+func linesRouter(provider LineProvider) http.Handler {
+	router := chi.NewRouter()
+	router.Get("/", provider.getLines)
+
+	router.Route("/{id}", func(subrouter chi.Router) {
+		subrouter.Get("/", provider.getLineById)
+	})
+
+	return router
+}
+
+func loadLines(warpProvider WarpProviderV2) LineProvider {
+	provider, err := readLines(warpProvider)
+	checkForErrors(err)
+
+	return provider
+}
+
+func readLines(warpProvider WarpProviderV2) (LineProvider, error) {
+	lines, err := readStaticData[Line](LINES_PATH)
+	if err != nil {
+		return LineProvider{}, err
+	}
+
+	return newLineProvider(lines, warpProvider)
+}
+
+// newLineProvider checks that each line has a unique ID, a valid colour and valid stops, and that it refers to an existing company and world.
+func newLineProvider(lines []Line, warpProvider WarpProviderV2) (LineProvider, error) {
+	snapshot := warpProvider.dataSnapshot()
+	linesByID := orderedmap.New[string, Line]()
+	linesByCompanyID := orderedmap.New[string, []Line]()
+
+	// Every company has a (possibly empty) list of lines
+	for _, company := range snapshot.companyProvider.companies {
+		linesByCompanyID.Set(company.ID, []Line{})
+	}
+
+	for i := range lines {
+		line := &lines[i]
+
+		if line.ID == "" {
+			return LineProvider{}, fmt.Errorf("The line '%s' has an empty ID", line.Name)
+		}
+
+		if _, exists := linesByID.Get(line.ID); exists {
+			return LineProvider{}, fmt.Errorf("The lines in %s have a duplicate ID: '%s'", LINES_PATH, line.ID)
+		}
+
+		if line.Colour != "" && !colourRegexp.MatchString(line.Colour) {
+			return LineProvider{}, fmt.Errorf("The line '%s' has an invalid colour: '%s'", line.ID, line.Colour)
+		}
+
+		for _, stop := range line.Stops {
+			stopRegexp, err := stopToRegexp(stop)
+			if err != nil {
+				return LineProvider{}, fmt.Errorf("The line '%s' has an invalid stop: '%s'", line.ID, stop)
+			}
+
+			line.stopRegexps = append(line.stopRegexps, stopRegexp)
+		}
+
+		linesByID.Set(line.ID, *line)
+
+		companyLines, _ := linesByCompanyID.Get(line.Company)
+		linesByCompanyID.Set(line.Company, append(companyLines, *line))
+	}
+
+	provider := LineProvider{
+		lines:            lines,
+		linesByID:        linesByID,
+		linesByCompanyID: linesByCompanyID,
+		warpProvider:     warpProvider,
+	}
+
+	err := provider.validateReferences(snapshot.companyProvider, snapshot.worldProvider)
+	if err != nil {
+		return LineProvider{}, err
+	}
+
+	return provider, nil
+}
+
+// validateReferences checks that the company and world of every line exist.
+// This is also used to reject reloading companies or worlds that lines still depend on.
+func (provider LineProvider) validateReferences(companyProvider CompanyProvider, worldProvider WorldProvider) error {
+	for _, line := range provider.lines {
+		if _, exists := companyProvider.companiesByID.Get(line.Company); !exists {
+			return fmt.Errorf("The line '%s' has an invalid company: '%s'", line.ID, line.Company)
+		}
+
+		if line.World != "" {
+			if _, exists := worldProvider.worldsByID.Get(line.World); !exists {
+				return fmt.Errorf("The line '%s' has an invalid world: '%s'", line.ID, line.World)
+			}
+		}
+	}
+
+	return nil
+}
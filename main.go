@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
@@ -18,9 +20,17 @@ import (
 )
 
 const (
-	DB_CONFIG_PATH = "config/db_config.yml"
-	COMPANIES_PATH = "data/companies.yml"
-	WORLDS_PATH    = "data/worlds.yml"
+	DB_CONFIG_PATH       = "config/db_config.yml"
+	ADMIN_CONFIG_PATH    = "config/admin_config.yml"
+	ROUTING_CONFIG_PATH  = "config/routing_config.yml"
+	STATIONS_CONFIG_PATH = "config/stations_config.yml"
+	COMPANIES_PATH       = "data/companies.yml"
+	WORLDS_PATH          = "data/worlds.yml"
+	LINES_PATH           = "data/lines.yml"
+	MODES_PATH           = "data/modes.yml"
+	ALLIANCES_PATH       = "data/alliances.yml"
+	AREAS_PATH           = "data/areas.yml"
+	LOGOS_PATH           = "data/logos"
 )
 
 const MAX_THROTTLE = 3
@@ -34,7 +44,7 @@ type DbConfig struct {
 }
 
 type StaticData interface {
-	Company | World
+	Company | World | Line | Mode | Alliance | Area
 	GetID() string
 }
 
@@ -48,22 +58,66 @@ type StaticData interface {
 // @externalDocs.description GitHub Repository
 // @externalDocs.url         https://github.com/Frumple/mrt-api
 
+// @securityDefinitions.apikey BearerAuth
+// @in                         header
+// @name                       Authorization
+// @description                Admin token from config/admin_config.yml, in the format 'Bearer <token>'.
+
 func main() {
+	strict := flag.Bool("strict", false, "Fail to start if there are any conflicts between companies, including overlapping patterns")
+	grpcAddr := flag.String("grpc-addr", ":9090", "Address that the gRPC service listens on, or an empty string to disable the gRPC service")
+	flag.Parse()
+
 	db := initializeDatabase()
 	defer db.Close()
 
-	companyProvider := loadCompanies()
-	worldProvider := loadWorlds()
+	adminConfig := loadAdminConfig()
+
+	// If the admin API is enabled, companies are stored in the database instead of companies.yml
+	var companyStore *CompanyStore
+	if adminConfig.Enabled {
+		companyStore = &CompanyStore{db: db}
+		err := companyStore.seed(context.Background())
+		checkForErrors(err)
+	}
+
+	data := loadDataStore(companyStore)
 	warpProviderV1 := WarpProviderV1{
-		db:              db,
-		companyProvider: companyProvider,
-		worldProvider:   worldProvider,
+		db:   db,
+		data: data,
 	}
 	warpProviderV2 := WarpProviderV2{
-		db:              db,
-		companyProvider: companyProvider,
-		worldProvider:   worldProvider,
+		db:   db,
+		data: data,
+	}
+
+	validationReport, err := warpProviderV2.validateCompanies(context.Background(), data.companyProvider().companies)
+	checkForErrors(err)
+	validationReport.log()
+	if validationReport.fails(*strict) {
+		panic("The companies have conflicts that must be resolved")
+	}
+
+	lineProvider := loadLines(warpProviderV2)
+	routeProvider := RouteProvider{
+		warpProvider: warpProviderV2,
+		lineProvider: lineProvider,
+		config:       loadRoutingConfig(),
+		graphs:       newRouteGraphCache(),
+	}
+	reportProvider := ReportProvider{
+		warpProvider: warpProviderV2,
+	}
+	stationProvider := loadStations(warpProviderV2)
+	go stationProvider.runClusteringJob()
+
+	reloader := DataReloader{
+		warpProvider: warpProviderV2,
+		lineProvider: lineProvider,
+		companyStore: companyStore,
+		strict:       *strict,
 	}
+	go reloader.watch()
 
 	router := chi.NewRouter()
 
@@ -77,19 +131,40 @@ func main() {
 	router.Route("/api", func(r chi.Router) {
 		r.Route("/v1", func(r chi.Router) {
 			r.Mount("/warps", warpsRouter(warpProviderV1))
-			r.Mount("/companies", companiesRouter(companyProvider))
-			r.Mount("/worlds", worldsRouter(worldProvider))
+			r.Mount("/companies", companiesRouter(warpProviderV2, lineProvider))
+			r.Mount("/worlds", worldsRouter(warpProviderV2))
 		})
 
 		r.Route("/v2", func(r chi.Router) {
-			r.Mount("/warps", warpsRouter(warpProviderV2))
-			r.Mount("/companies", companiesRouter(companyProvider))
-			r.Mount("/worlds", worldsRouter(worldProvider))
+			r.Mount("/warps", warpsRouterV2(warpProviderV2))
+			r.Mount("/companies", companiesRouter(warpProviderV2, lineProvider))
+			r.Mount("/worlds", worldsRouter(warpProviderV2))
+			r.Mount("/players", playersRouter(warpProviderV2))
+			r.Mount("/modes", modesRouter(data))
+			r.Mount("/alliances", alliancesRouter(data))
+			r.Mount("/areas", areasRouter(warpProviderV2))
+			r.Mount("/lines", linesRouter(lineProvider))
+			r.Mount("/routes", routesRouter(routeProvider))
+			r.Mount("/stations", stationsRouter(stationProvider))
+			r.Mount("/reports", reportsRouter(reportProvider))
+
+			if companyStore != nil {
+				adminProvider := AdminProvider{
+					reloader:     reloader,
+					companyStore: *companyStore,
+					config:       adminConfig,
+				}
+				r.Mount("/admin", adminRouter(adminProvider))
+			}
 		})
 	})
 
 	router.Get("/swagger/*", httpSwagger.WrapHandler)
 
+	if *grpcAddr != "" {
+		go serveGrpc(*grpcAddr, warpProviderV2)
+	}
+
 	addr := ":8080"
 	log.Println("API server started on: ", addr)
 	log.Fatal(http.ListenAndServe(addr, router))
@@ -122,15 +197,26 @@ func buildConnectionString() string {
 }
 
 func loadStaticData[V StaticData](yamlFilePath string) []V {
+	vSlice, err := readStaticData[V](yamlFilePath)
+	checkForErrors(err)
+
+	return vSlice
+}
+
+func readStaticData[V StaticData](yamlFilePath string) ([]V, error) {
 	vSlice := []V{}
 
 	data, err := os.ReadFile(yamlFilePath)
-	checkForErrors(err)
+	if err != nil {
+		return nil, err
+	}
 
 	err = yaml.Unmarshal([]byte(data), &vSlice)
-	checkForErrors(err)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", yamlFilePath, err)
+	}
 
-	return vSlice
+	return vSlice, nil
 }
 
 func staticDataToOrderedMap[V StaticData](vSlice []V) *orderedmap.OrderedMap[string, V] {
@@ -192,3 +278,21 @@ var ErrorNotFound = &Error{
 	HTTPStatusCode: 404,
 	Message:        "Resource not found.",
 }
+
+var ErrorUnauthorized = &Error{
+	HTTPStatusCode: 401,
+	Message:        "Unauthorized.",
+}
+
+func ErrorConflict(detail string) render.Renderer {
+	return &Error{
+		HTTPStatusCode: 409,
+		Message:        "Conflict.",
+		Detail:         detail,
+	}
+}
+
+var ErrorServiceUnavailable = &Error{
+	HTTPStatusCode: 503,
+	Message:        "Resource is not available yet, please try again later.",
+}
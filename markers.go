@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"gopkg.in/yaml.v3"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+// Dynmap marker sets, in the format of Dynmap's markers.yml
+type DynmapMarkerFile struct {
+	Sets map[string]DynmapMarkerSet `yaml:"sets"`
+}
+
+type DynmapMarkerSet struct {
+	Label     string                  `yaml:"label"`
+	Hide      bool                    `yaml:"hide"`
+	LayerPrio int                     `yaml:"layerprio"`
+	Markers   map[string]DynmapMarker `yaml:"markers"`
+}
+
+type DynmapMarker struct {
+	World  string  `yaml:"world"`
+	X      float64 `yaml:"x"`
+	Y      float64 `yaml:"y"`
+	Z      float64 `yaml:"z"`
+	Icon   string  `yaml:"icon"`
+	Label  string  `yaml:"label"`
+	Markup bool    `yaml:"markup"`
+}
+
+// BlueMap marker sets, in the format of BlueMap's markers.json
+type BlueMapMarkerSet struct {
+	Label         string                   `json:"label"`
+	Toggleable    bool                     `json:"toggleable"`
+	DefaultHidden bool                     `json:"default-hidden"`
+	Sorting       int                      `json:"sorting"`
+	Markers       map[string]BlueMapMarker `json:"markers"`
+}
+
+type BlueMapMarker struct {
+	Type     string          `json:"type"`
+	Position BlueMapPosition `json:"position"`
+	Label    string          `json:"label"`
+	Icon     string          `json:"icon"`
+}
+
+type BlueMapPosition struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// getWorldMarkers godoc
+// @summary     Get map markers for world
+// @description Get markers for all company warps in a world, with one marker set per company (defined in https://github.com/Frumple/mrt-api/blob/main/data/companies.yml).
+// @description Dynmap markers are returned as YAML in the format of markers.yml. BlueMap markers are returned as JSON in the format of markers.json.
+// @tags        Worlds
+// @produce     application/yaml
+// @produce     json
+// @param       id     path     string true  "World ID"
+// @param       format query    string false "Marker format: 'dynmap' (default) or 'bluemap'."
+// @success     200    {object} map[string]BlueMapMarkerSet
+// @failure     400    {object} Error
+// @failure     404    {object} Error
+// @router      /worlds/{id}/markers [get]
+func (provider WarpProviderV2) getWorldMarkers(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+	format := request.URL.Query().Get("format")
+
+	world, exists := provider.worldProvider().worldsByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	if format != "" && format != "dynmap" && format != "bluemap" {
+		detail := "The 'format' query parameter must be one of 'dynmap' or 'bluemap'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	warpsByCompanyID, err := provider.queryCompanyWarpsInWorld(request.Context(), world)
+	checkForErrors(err)
+
+	if format == "bluemap" {
+		writer.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(writer).Encode(provider.buildBlueMapMarkerSets(warpsByCompanyID))
+		checkForErrors(err)
+		return
+	}
+
+	data, err := yaml.Marshal(provider.buildDynmapMarkerFile(world, warpsByCompanyID))
+	checkForErrors(err)
+
+	writer.Header().Set("Content-Type", "application/yaml")
+	writer.Write(data)
+}
+
+// queryCompanyWarpsInWorld returns all warps in the world that belong to a company, grouped by company ID.
+func (provider WarpProviderV2) queryCompanyWarpsInWorld(ctx context.Context, world World) (map[string][]Warp, error) {
+	warpsByCompanyID := map[string][]Warp{}
+
+	orExpressions := []BoolExpression{}
+	for _, company := range provider.companyProvider().companies {
+		orExpressions = append(orExpressions, company.warpCondition())
+	}
+
+	if len(orExpressions) == 0 {
+		return warpsByCompanyID, nil
+	}
+
+	condition := AND(
+		table.World.UUID.EQ(String(world.UUID)),
+		OR(orExpressions...),
+	)
+
+	err := provider.streamWarps(ctx, condition, table.Warp.Name.ASC(), func(warp Warp) error {
+		company, exists := provider.companyProvider().findCompanyForWarp(warp.Name)
+		if exists {
+			warpsByCompanyID[company.ID] = append(warpsByCompanyID[company.ID], warp)
+		}
+		return nil
+	})
+
+	return warpsByCompanyID, err
+}
+
+func (provider WarpProviderV2) buildDynmapMarkerFile(world World, warpsByCompanyID map[string][]Warp) DynmapMarkerFile {
+	sets := map[string]DynmapMarkerSet{}
+
+	for i, company := range provider.companyProvider().companies {
+		warps, exists := warpsByCompanyID[company.ID]
+		if !exists {
+			continue
+		}
+
+		markers := map[string]DynmapMarker{}
+		for _, warp := range warps {
+			markers[fmt.Sprintf("warp_%d", warp.ID)] = DynmapMarker{
+				World: world.ID,
+				X:     warp.X,
+				Y:     warp.Y,
+				Z:     warp.Z,
+				Icon:  provider.modeProvider().getMode(company.Mode).Icon.Dynmap,
+				Label: warp.Name,
+			}
+		}
+
+		sets[fmt.Sprintf("mrt_%s", company.ID)] = DynmapMarkerSet{
+			Label:     company.Name,
+			LayerPrio: i,
+			Markers:   markers,
+		}
+	}
+
+	return DynmapMarkerFile{Sets: sets}
+}
+
+func (provider WarpProviderV2) buildBlueMapMarkerSets(warpsByCompanyID map[string][]Warp) map[string]BlueMapMarkerSet {
+	sets := map[string]BlueMapMarkerSet{}
+
+	for i, company := range provider.companyProvider().companies {
+		warps, exists := warpsByCompanyID[company.ID]
+		if !exists {
+			continue
+		}
+
+		markers := map[string]BlueMapMarker{}
+		for _, warp := range warps {
+			markers[fmt.Sprintf("warp_%d", warp.ID)] = BlueMapMarker{
+				Type:     "poi",
+				Position: BlueMapPosition{warp.X, warp.Y, warp.Z},
+				Label:    warp.Name,
+				Icon:     provider.modeProvider().getMode(company.Mode).Icon.BlueMap,
+			}
+		}
+
+		sets[fmt.Sprintf("mrt_%s", company.ID)] = BlueMapMarkerSet{
+			Label:      company.Name,
+			Toggleable: true,
+			Sorting:    i,
+			Markers:    markers,
+		}
+	}
+
+	return sets
+}
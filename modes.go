@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+)
+
+type TransportMode string
+
+// Used for warps that do not belong to any company, so this mode must always be defined
+const Other TransportMode = "other"
+
+type Mode struct {
+	ID     TransportMode `json:"id"`
+	Name   string        `json:"name"`
+	Colour string        `json:"colour"`
+	Icon   ModeIcon      `json:"icon"`
+	Order  int           `json:"order"`
+}
+
+type ModeIcon struct {
+	// ID of a built-in Dynmap marker icon
+	Dynmap string `json:"dynmap"`
+	// Path of an image relative to the BlueMap webroot
+	BlueMap string `json:"bluemap" yaml:"bluemap"`
+}
+
+func (mode Mode) GetID() string {
+	return string(mode.ID)
+}
+
+func (mode Mode) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type ModeProvider struct {
+	modes     []Mode
+	modesByID *orderedmap.OrderedMap[string, Mode]
+}
+
+// getModes     godoc
+// @summary     List all transport modes
+// @description List all transport modes that companies can have (defined in https://github.com/Frumple/mrt-api/blob/main/data/modes.yml).
+// @tags        Modes
+// @produce     json
+// @success     200 {array} Mode
+// @router      /modes [get]
+func (provider ModeProvider) getModes(writer http.ResponseWriter, request *http.Request) {
+	err := render.RenderList(writer, request, toRenderList(provider.modes))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getModeById  godoc
+// @summary     Get transport mode by ID
+// @description Get transport mode by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/modes.yml).
+// @tags        Modes
+// @produce     json
+// @param       id  path     string true "Mode ID"
+// @success     200 {object} Mode
+// @failure     404 {object} Error
+// @router      /modes/{id} [get]
+func (provider ModeProvider) getModeById(writer http.ResponseWriter, request *http.Request) {
+	id := chi.URLParam(request, "id")
+
+	mode, exists := provider.modesByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	err := render.Render(writer, request, mode)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getMode returns the mode with the given ID, or the 'other' mode if it does not exist.
+func (provider ModeProvider) getMode(id TransportMode) Mode {
+	mode, exists := provider.modesByID.Get(string(id))
+	if !exists {
+		mode, _ = provider.modesByID.Get(string(Other))
+	}
+
+	return mode
+}
+
+// invalidModeMessage returns the error message for a query parameter that is not one of the mode IDs.
+func (provider ModeProvider) invalidModeMessage(parameter string) string {
+	return fmt.Sprintf("The '%s' query parameter must be one of %s.", parameter, provider.listModeIDs())
+}
+
+// listModeIDs returns the quoted mode IDs in order, in the format "'a', 'b', or 'c'".
+func (provider ModeProvider) listModeIDs() string {
+	ids := []string{}
+	for _, mode := range provider.modes {
+		ids = append(ids, fmt.Sprintf("'%s'", mode.ID))
+	}
+
+	if len(ids) > 1 {
+		ids[len(ids)-1] = "or " + ids[len(ids)-1]
+	}
+
+	separator := ", "
+	if len(ids) == 2 {
+		separator = " "
+	}
+
+	return strings.Join(ids, separator)
+}
+
+func modesRouter(data *DataStore) http.Handler {
+	router := chi.NewRouter()
+	router.Get("/", data.modeHandler(ModeProvider.getModes))
+
+	router.Route("/{id}", func(subrouter chi.Router) {
+		subrouter.Get("/", data.modeHandler(ModeProvider.getModeById))
+	})
+
+	return router
+}
+
+func readModes() (ModeProvider, error) {
+	modes, err := readStaticData[Mode](MODES_PATH)
+	if err != nil {
+		return ModeProvider{}, err
+	}
+
+	for _, mode := range modes {
+		if mode.ID == "" {
+			return ModeProvider{}, fmt.Errorf("The mode '%s' has an empty ID", mode.Name)
+		}
+
+		if !colourRegexp.MatchString(mode.Colour) {
+			return ModeProvider{}, fmt.Errorf("The mode '%s' has an invalid colour: '%s'", mode.ID, mode.Colour)
+		}
+	}
+
+	sort.SliceStable(modes, func(i, j int) bool {
+		return modes[i].Order < modes[j].Order
+	})
+
+	modesByID := staticDataToOrderedMap(modes)
+
+	if modesByID.Len() != len(modes) {
+		return ModeProvider{}, fmt.Errorf("The modes in %s have duplicate IDs", MODES_PATH)
+	}
+
+	if _, exists := modesByID.Get(string(Other)); !exists {
+		return ModeProvider{}, fmt.Errorf("The mode '%s' is required", Other)
+	}
+
+	return ModeProvider{
+		modes:     modes,
+		modesByID: modesByID,
+	}, nil
+}
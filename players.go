@@ -0,0 +1,60 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// getPlayerWarps godoc
+// @summary     List warps of player
+// @description List the warps owned by a player, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.
+// @tags        Players
+// @produce     json
+// @produce     application/geo+json
+// @param       uuid           path     string true  "Player UUID (can be with or without hyphens)"
+// @param       format         query    string false "Response format: 'json' (default) or 'geojson'."
+// @param       name           query    string false "Filter by warp name."
+// @param       company        query    string false "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company."
+// @param       include_legacy query    bool   false "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter."
+// @param       alliance       query    string false "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance."
+// @param       mode           query    string false "Filter by transport mode ID (from /modes)."
+// @param       world          query    string false "Filter by world ID (from /worlds)."
+// @param       type           query    int    false "Filter by type (0 = private, 1 = public)."
+// @param       region         query    string false "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files."
+// @param       chunk          query    string false "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40')."
+// @param       area           query    string false "Filter by area ID (from /areas), matching the warps that lie within the area."
+// @param       order_by       query    string false "Order by 'name', 'creation_date', or 'visits'."
+// @param       sort_by        query    string false "Sort by 'asc' (ascending) or 'desc' (descending)."
+// @param       limit          query    int    false "Limit number of warps returned. Maximum limit is 2000."
+// @param       offset         query    int    false "Number of warps to skip before returning."
+// @param       coords         query    string false "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8)."
+// @success     200            {object} WarpResponse
+// @failure     400            {object} Error
+// @router      /players/{uuid}/warps [get]
+func (provider WarpProviderV2) getPlayerWarps(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	playerUUID, valid := normalizePlayerUUID(chi.URLParam(request, "uuid"))
+	if !valid {
+		detail := "The 'uuid' parameter must be a UUID that has 32 hexadecimal digits (with or without hyphens)."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	parameters := warpQueryParametersFromRequest(request)
+	parameters.PlayerUUID = playerUUID
+
+	provider.renderWarps(writer, request, parameters)
+}
+
+func playersRouter(warpProvider WarpProviderV2) http.Handler {
+	router := chi.NewRouter()
+
+	router.Route("/{uuid}", func(subrouter chi.Router) {
+		subrouter.Get("/warps", warpProvider.getPlayerWarps)
+	})
+
+	return router
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+const (
+	DEFAULT_DUPLICATE_TOLERANCE = 1.0
+	MAX_DUPLICATE_TOLERANCE     = 16.0
+)
+
+type DuplicateGroup struct {
+	// Normalized name, or rounded coordinates in the format 'x,y,z'
+	Key         string   `json:"key"`
+	PlayerUUIDs []string `json:"playerUUIDs"`
+	Warps       []Warp   `json:"warps"`
+}
+
+type DuplicateReport struct {
+	CoordinateGroups []DuplicateGroup `json:"coordinateGroups"`
+	NameGroups       []DuplicateGroup `json:"nameGroups"`
+}
+
+func (report DuplicateReport) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type ReportProvider struct {
+	warpProvider WarpProviderV2
+}
+
+// getDuplicatesReport godoc
+// @summary     Report duplicate warps
+// @description List groups of warps from different owners that are at the same coordinates (within a tolerance) in the same world, or that have the same normalized name.
+// @description Names are normalized by ignoring case and any characters that are not letters or digits.
+// @description Each coordinate group starts from its oldest warp, and only contains warps within the tolerance of that warp, so warps in the same group are at most twice the tolerance apart.
+// @tags        Reports
+// @produce     json
+// @param       world              query    string false "Filter by world ID (from /worlds)."
+// @param       tolerance          query    number false "Maximum distance (in blocks) between warps at the same coordinates. Default is 1, maximum is 16."
+// @param       by                 query    string false "Only report duplicates by 'coordinates' or 'name'."
+// @param       include_same_owner query    bool   false "If 'true', also report groups whose warps all have the same owner."
+// @success     200                {object} DuplicateReport
+// @failure     400                {object} Error
+// @router      /reports/duplicates [get]
+func (provider ReportProvider) getDuplicatesReport(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	worldID := request.URL.Query().Get("world")
+	toleranceStr := request.URL.Query().Get("tolerance")
+	by := request.URL.Query().Get("by")
+	includeSameOwnerStr := request.URL.Query().Get("include_same_owner")
+
+	worlds := provider.warpProvider.worldProvider().worlds
+
+	if worldID != "" {
+		world, exists := provider.warpProvider.worldProvider().worldsByID.Get(worldID)
+		if !exists {
+			detail := "The 'world' query parameter must be one of the IDs returned from the /worlds endpoint."
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		worlds = []World{world}
+	}
+
+	tolerance := DEFAULT_DUPLICATE_TOLERANCE
+
+	if toleranceStr != "" {
+		newTolerance, err := strconv.ParseFloat(toleranceStr, 64)
+		if err != nil || newTolerance < 0 || newTolerance > MAX_DUPLICATE_TOLERANCE {
+			detail := fmt.Sprintf("The 'tolerance' query parameter must be a number within the following range: 0 <= tolerance <= %g.", MAX_DUPLICATE_TOLERANCE)
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		tolerance = newTolerance
+	}
+
+	if by != "" && by != "coordinates" && by != "name" {
+		detail := "The 'by' query parameter must be one of 'coordinates' or 'name'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	if includeSameOwnerStr != "" && includeSameOwnerStr != "true" && includeSameOwnerStr != "false" {
+		detail := "The 'include_same_owner' query parameter must be either 'true' or 'false'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	includeSameOwner := includeSameOwnerStr == "true"
+
+	report := DuplicateReport{
+		CoordinateGroups: []DuplicateGroup{},
+		NameGroups:       []DuplicateGroup{},
+	}
+
+	allWarps := []Warp{}
+
+	for _, world := range worlds {
+		warps := []Warp{}
+
+		condition := table.World.UUID.EQ(String(world.UUID))
+		err := provider.warpProvider.streamWarps(request.Context(), condition, table.Warp.WarpID.ASC(), func(warp Warp) error {
+			warps = append(warps, warp)
+			return nil
+		})
+		checkForErrors(err)
+
+		if by != "name" {
+			report.CoordinateGroups = append(report.CoordinateGroups, findCoordinateDuplicates(warps, tolerance)...)
+		}
+
+		allWarps = append(allWarps, warps...)
+	}
+
+	if by != "coordinates" {
+		report.NameGroups = findNameDuplicates(allWarps)
+	}
+
+	if !includeSameOwner {
+		report.CoordinateGroups = filterSameOwnerGroups(report.CoordinateGroups)
+		report.NameGroups = filterSameOwnerGroups(report.NameGroups)
+	}
+
+	err := render.Render(writer, request, report)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// findCoordinateDuplicates groups warps (all in the same world) that are within the tolerance of each other in all three dimensions.
+// Each group starts from the first warp that is not already in a group, and only takes the other warps within the tolerance of that warp,
+// so that a chain of warps that are each just within the tolerance of the next is not reported as a single group.
+func findCoordinateDuplicates(warps []Warp, tolerance float64) []DuplicateGroup {
+	points := []Point{}
+	for _, warp := range warps {
+		points = append(points, Point{warp.X, warp.Z})
+	}
+
+	// A tolerance of zero still needs to match warps at exactly the same coordinates
+	searchDistance := math.Max(tolerance, 1e-6)
+
+	neighbours := make([][]int, len(warps))
+	for _, pair := range findNearbyPairs(points, searchDistance) {
+		if math.Abs(warps[pair[0]].Y-warps[pair[1]].Y) <= tolerance {
+			neighbours[pair[0]] = append(neighbours[pair[0]], pair[1])
+			neighbours[pair[1]] = append(neighbours[pair[1]], pair[0])
+		}
+	}
+
+	grouped := make([]bool, len(warps))
+	groups := []DuplicateGroup{}
+
+	// Warps are ordered by ID, so each group starts from its oldest warp
+	for anchor := range warps {
+		if grouped[anchor] {
+			continue
+		}
+
+		cluster := []int{anchor}
+		for _, i := range neighbours[anchor] {
+			if !grouped[i] {
+				cluster = append(cluster, i)
+			}
+		}
+
+		if len(cluster) < 2 {
+			continue
+		}
+
+		sort.Ints(cluster)
+
+		members := []Warp{}
+		for _, i := range cluster {
+			grouped[i] = true
+			members = append(members, warps[i])
+		}
+
+		first := members[0]
+		key := fmt.Sprintf("%d,%d,%d", int(math.Floor(first.X)), int(math.Floor(first.Y)), int(math.Floor(first.Z)))
+		groups = append(groups, newDuplicateGroup(key, members))
+	}
+
+	return groups
+}
+
+// findNameDuplicates groups warps that have the same normalized name.
+func findNameDuplicates(warps []Warp) []DuplicateGroup {
+	warpsByName := map[string][]Warp{}
+	for _, warp := range warps {
+		name := normalizeWarpName(warp.Name)
+		if name != "" {
+			warpsByName[name] = append(warpsByName[name], warp)
+		}
+	}
+
+	names := []string{}
+	for name, members := range warpsByName {
+		if len(members) > 1 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	groups := []DuplicateGroup{}
+	for _, name := range names {
+		groups = append(groups, newDuplicateGroup(name, warpsByName[name]))
+	}
+
+	return groups
+}
+
+func newDuplicateGroup(key string, warps []Warp) DuplicateGroup {
+	playerUUIDs := []string{}
+	for _, warp := range warps {
+		if !containsString(playerUUIDs, warp.PlayerUUID) {
+			playerUUIDs = append(playerUUIDs, warp.PlayerUUID)
+		}
+	}
+
+	return DuplicateGroup{
+		Key:         key,
+		PlayerUUIDs: playerUUIDs,
+		Warps:       warps,
+	}
+}
+
+// filterSameOwnerGroups removes the groups whose warps all have the same owner.
+func filterSameOwnerGroups(groups []DuplicateGroup) []DuplicateGroup {
+	filtered := []DuplicateGroup{}
+	for _, group := range groups {
+		if len(group.PlayerUUIDs) > 1 {
+			filtered = append(filtered, group)
+		}
+	}
+
+	return filtered
+}
+
+// normalizeWarpName converts the name to lower case and removes all characters that are not letters or digits.
+func normalizeWarpName(name string) string {
+	return strings.Map(func(character rune) rune {
+		if unicode.IsLetter(character) || unicode.IsDigit(character) {
+			return unicode.ToLower(character)
+		}
+		return -1
+	}, name)
+}
+
+// getCompaniesReport godoc
+// @summary     Report company conflicts
+// @description List conflicts between companies: duplicate IDs, pairs of patterns that can match the same warp name, warps that are matched by more than one company, companies that do not match any warps, and company regexes that match warps differently in MySQL and Go.
+// @description Each conflict includes example warp names. Where patterns overlap, warps belong to whichever company is listed first in data/companies.yml.
+// @tags        Reports
+// @produce     json
+// @success     200 {object} CompanyValidationReport
+// @router      /reports/companies [get]
+func (provider ReportProvider) getCompaniesReport(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	companies := provider.warpProvider.companyProvider().companies
+
+	report, err := provider.warpProvider.validateCompanies(request.Context(), companies)
+	checkForErrors(err)
+
+	err = render.Render(writer, request, report)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+func reportsRouter(provider ReportProvider) http.Handler {
+	router := chi.NewRouter()
+	router.Get("/companies", provider.getCompaniesReport)
+	router.Get("/duplicates", provider.getDuplicatesReport)
+	return router
+}
@@ -0,0 +1,477 @@
+package main
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+	"strings"
+)
+
+type RouteEdgeType string
+
+const (
+	RideEdge RouteEdgeType = "ride"
+	WalkEdge RouteEdgeType = "walk"
+)
+
+// Line of ordered stops, used to connect consecutive warps in the route graph
+type RouteLine struct {
+	ID      string
+	Company Company
+	Warps   []Warp
+}
+
+type RouteNode struct {
+	// Nil if the node is an arbitrary point rather than a warp
+	Warp *Warp
+	X    float64
+	Z    float64
+}
+
+type RouteEdge struct {
+	To       int
+	Type     RouteEdgeType
+	Line     int
+	Distance float64
+	Cost     float64
+
+	// True if the edge is a walking transfer between two warps, which is only used if it is within the maximum transfer distance
+	Transfer bool
+}
+
+type RouteGraph struct {
+	config RoutingConfig
+
+	nodes []RouteNode
+	edges [][]RouteEdge
+	lines []RouteLine
+
+	// Map of destination nodes to the index of the edge in edges, for each node
+	edgeIndexes []map[int]int
+
+	nodesByWarpID map[uint32]int
+
+	// Nodes below this index share their edges with the graph that this graph was cloned from, unless they are in ownedNodes
+	sharedNodes int
+	ownedNodes  map[int]bool
+}
+
+func newRouteGraph(config RoutingConfig, lines []RouteLine) *RouteGraph {
+	graph := &RouteGraph{
+		config:        config,
+		lines:         lines,
+		nodesByWarpID: map[uint32]int{},
+		ownedNodes:    map[int]bool{},
+	}
+
+	// Connect consecutive stops on each line in both directions
+	for lineIndex, line := range lines {
+		speed := config.Speeds[line.Company.Mode]
+		if speed <= 0 {
+			continue
+		}
+
+		previous := -1
+		for i := range line.Warps {
+			current := graph.addWarpNode(line.Warps[i])
+
+			if previous >= 0 {
+				distance := graph.distance(previous, current)
+				graph.addEdge(previous, RouteEdge{To: current, Type: RideEdge, Line: lineIndex, Distance: distance, Cost: distance / speed})
+				graph.addEdge(current, RouteEdge{To: previous, Type: RideEdge, Line: lineIndex, Distance: distance, Cost: distance / speed})
+			}
+
+			previous = current
+		}
+	}
+
+	// Connect all warps that are within walking distance of each other
+	points := []Point{}
+	for _, node := range graph.nodes {
+		points = append(points, Point{node.X, node.Z})
+	}
+
+	for _, pair := range findNearbyPairs(points, config.MaxTransferDistance) {
+		distance := graph.distance(pair[0], pair[1])
+		cost := distance/config.WalkingSpeed + config.TransferPenalty
+		graph.addEdge(pair[0], RouteEdge{To: pair[1], Type: WalkEdge, Line: -1, Distance: distance, Cost: cost, Transfer: true})
+		graph.addEdge(pair[1], RouteEdge{To: pair[0], Type: WalkEdge, Line: -1, Distance: distance, Cost: cost, Transfer: true})
+	}
+
+	return graph
+}
+
+// clone returns a copy of the graph that nodes and edges can be added to without changing this graph, using the config instead.
+// Edges are only copied when they are changed, so that a graph can be reused by many requests without copying it entirely.
+// Transfers that are longer than the maximum transfer distance of the config are not used by the copy.
+func (graph *RouteGraph) clone(config RoutingConfig) *RouteGraph {
+	// Copy the outer slices, so that replacing the edges of a node never writes to this graph.
+	// The edges of each node are still shared until ownEdges copies them.
+	return &RouteGraph{
+		config:        config,
+		nodes:         append([]RouteNode(nil), graph.nodes...),
+		edges:         append([][]RouteEdge(nil), graph.edges...),
+		lines:         graph.lines,
+		edgeIndexes:   append([]map[int]int(nil), graph.edgeIndexes...),
+		nodesByWarpID: graph.nodesByWarpID,
+		sharedNodes:   len(graph.nodes),
+		ownedNodes:    map[int]bool{},
+	}
+}
+
+// ownEdges copies the edges of the node before they are changed, if they are shared with another graph.
+func (graph *RouteGraph) ownEdges(node int) {
+	if node >= graph.sharedNodes || graph.ownedNodes[node] {
+		return
+	}
+
+	graph.edges[node] = append([]RouteEdge{}, graph.edges[node]...)
+
+	edgeIndexes := make(map[int]int, len(graph.edgeIndexes[node]))
+	for to, index := range graph.edgeIndexes[node] {
+		edgeIndexes[to] = index
+	}
+	graph.edgeIndexes[node] = edgeIndexes
+
+	graph.ownedNodes[node] = true
+}
+
+func (graph *RouteGraph) addWarpNode(warp Warp) int {
+	if index, exists := graph.nodesByWarpID[warp.ID]; exists {
+		return index
+	}
+
+	index := graph.addNode(RouteNode{Warp: &warp, X: warp.X, Z: warp.Z})
+	graph.nodesByWarpID[warp.ID] = index
+	return index
+}
+
+func (graph *RouteGraph) addNode(node RouteNode) int {
+	graph.nodes = append(graph.nodes, node)
+	graph.edges = append(graph.edges, []RouteEdge{})
+	graph.edgeIndexes = append(graph.edgeIndexes, map[int]int{})
+	return len(graph.nodes) - 1
+}
+
+// addEdge adds a directed edge, or replaces the existing edge between the same nodes if the new one is cheaper.
+func (graph *RouteGraph) addEdge(from int, edge RouteEdge) {
+	if index, exists := graph.edgeIndexes[from][edge.To]; exists {
+		if edge.Cost < graph.edges[from][index].Cost {
+			graph.ownEdges(from)
+			graph.edges[from][index] = edge
+		}
+		return
+	}
+
+	graph.ownEdges(from)
+	graph.edgeIndexes[from][edge.To] = len(graph.edges[from])
+	graph.edges[from] = append(graph.edges[from], edge)
+}
+
+func (graph *RouteGraph) findEdge(from int, to int) (RouteEdge, bool) {
+	index, exists := graph.edgeIndexes[from][to]
+	if !exists {
+		return RouteEdge{}, false
+	}
+
+	return graph.edges[from][index], true
+}
+
+// isUsable returns false if the edge is a transfer that is longer than the maximum transfer distance.
+func (graph *RouteGraph) isUsable(edge RouteEdge) bool {
+	return !edge.Transfer || edge.Distance <= graph.config.MaxTransferDistance
+}
+
+func (graph *RouteGraph) distance(from int, to int) float64 {
+	return math.Hypot(graph.nodes[from].X-graph.nodes[to].X, graph.nodes[from].Z-graph.nodes[to].Z)
+}
+
+// addPointNode adds a node for an arbitrary point, connected by walking to all warps within transfer distance.
+// If no warps are close enough, the point is connected to the nearest warp instead.
+func (graph *RouteGraph) addPointNode(node RouteNode) int {
+	index := graph.addNode(node)
+
+	nearest := -1
+	connected := false
+
+	for other := 0; other < index; other++ {
+		if graph.nodes[other].Warp == nil {
+			continue
+		}
+
+		distance := graph.distance(index, other)
+		if distance <= graph.config.MaxTransferDistance {
+			graph.addWalkEdges(index, other)
+			connected = true
+		}
+
+		if nearest < 0 || distance < graph.distance(index, nearest) {
+			nearest = other
+		}
+	}
+
+	if !connected && nearest >= 0 {
+		graph.addWalkEdges(index, nearest)
+	}
+
+	return index
+}
+
+// addWalkEdges connects two nodes by walking in both directions, without any transfer penalty.
+func (graph *RouteGraph) addWalkEdges(from int, to int) {
+	distance := graph.distance(from, to)
+	cost := distance / graph.config.WalkingSpeed
+	graph.addEdge(from, RouteEdge{To: to, Type: WalkEdge, Line: -1, Distance: distance, Cost: cost})
+	graph.addEdge(to, RouteEdge{To: from, Type: WalkEdge, Line: -1, Distance: distance, Cost: cost})
+}
+
+type RoutePath struct {
+	Nodes []int
+	Cost  float64
+}
+
+// findShortestPaths returns up to k loopless paths from source to target in ascending order of cost, using Yen's algorithm.
+func (graph *RouteGraph) findShortestPaths(source int, target int, k int) []RoutePath {
+	paths := []RoutePath{}
+
+	first, found := graph.findShortestPath(source, target, map[int]bool{}, map[[2]int]bool{})
+	if !found {
+		return paths
+	}
+	paths = append(paths, first)
+
+	candidates := []RoutePath{}
+
+	for len(paths) < k {
+		previous := paths[len(paths)-1]
+
+		for i := 0; i < len(previous.Nodes)-1; i++ {
+			spurNode := previous.Nodes[i]
+			rootNodes := previous.Nodes[:i+1]
+
+			blockedEdges := map[[2]int]bool{}
+			for _, path := range paths {
+				if len(path.Nodes) > i+1 && equalNodes(path.Nodes[:i+1], rootNodes) {
+					blockedEdges[[2]int{path.Nodes[i], path.Nodes[i+1]}] = true
+				}
+			}
+
+			blockedNodes := map[int]bool{}
+			for _, node := range rootNodes[:i] {
+				blockedNodes[node] = true
+			}
+
+			spurPath, found := graph.findShortestPath(spurNode, target, blockedNodes, blockedEdges)
+			if !found {
+				continue
+			}
+
+			nodes := append(append([]int{}, rootNodes[:i]...), spurPath.Nodes...)
+			candidate := RoutePath{Nodes: nodes, Cost: graph.pathCost(nodes)}
+
+			if !containsPath(candidates, candidate) && !containsPath(paths, candidate) {
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return candidates[a].Cost < candidates[b].Cost
+		})
+
+		paths = append(paths, candidates[0])
+		candidates = candidates[1:]
+	}
+
+	return paths
+}
+
+// findShortestPath returns the cheapest path from source to target using Dijkstra's algorithm, avoiding blocked nodes and edges.
+func (graph *RouteGraph) findShortestPath(source int, target int, blockedNodes map[int]bool, blockedEdges map[[2]int]bool) (RoutePath, bool) {
+	costs := make([]float64, len(graph.nodes))
+	previous := make([]int, len(graph.nodes))
+	for i := range costs {
+		costs[i] = math.Inf(1)
+		previous[i] = -1
+	}
+	costs[source] = 0
+
+	queue := &routeQueue{{node: source, cost: 0}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(routeQueueItem)
+		if item.cost > costs[item.node] {
+			continue
+		}
+
+		if item.node == target {
+			break
+		}
+
+		for _, edge := range graph.edges[item.node] {
+			if blockedNodes[edge.To] || blockedEdges[[2]int{item.node, edge.To}] || !graph.isUsable(edge) {
+				continue
+			}
+
+			cost := item.cost + edge.Cost
+			if cost < costs[edge.To] {
+				costs[edge.To] = cost
+				previous[edge.To] = item.node
+				heap.Push(queue, routeQueueItem{node: edge.To, cost: cost})
+			}
+		}
+	}
+
+	if math.IsInf(costs[target], 1) {
+		return RoutePath{}, false
+	}
+
+	nodes := []int{}
+	for node := target; node >= 0; node = previous[node] {
+		nodes = append([]int{node}, nodes...)
+	}
+
+	return RoutePath{Nodes: nodes, Cost: costs[target]}, true
+}
+
+func (graph *RouteGraph) pathCost(nodes []int) float64 {
+	cost := 0.0
+	for i := 0; i < len(nodes)-1; i++ {
+		edge, _ := graph.findEdge(nodes[i], nodes[i+1])
+		cost += edge.Cost
+	}
+	return cost
+}
+
+func equalNodes(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsPath(paths []RoutePath, path RoutePath) bool {
+	for _, other := range paths {
+		if equalNodes(other.Nodes, path.Nodes) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type routeQueueItem struct {
+	node int
+	cost float64
+}
+
+// Priority queue of nodes ordered by cost, for use with container/heap
+type routeQueue []routeQueueItem
+
+func (queue routeQueue) Len() int           { return len(queue) }
+func (queue routeQueue) Less(i, j int) bool { return queue[i].cost < queue[j].cost }
+func (queue routeQueue) Swap(i, j int)      { queue[i], queue[j] = queue[j], queue[i] }
+
+func (queue *routeQueue) Push(item any) {
+	*queue = append(*queue, item.(routeQueueItem))
+}
+
+func (queue *routeQueue) Pop() any {
+	old := *queue
+	item := old[len(old)-1]
+	*queue = old[:len(old)-1]
+	return item
+}
+
+// inferRouteLines groups the warps of each company into lines, for companies that do not have lines defined in lines.yml.
+// Warps are grouped by the first segment of their name (e.g. "IR12" for "IR12-Foo-Bar"), and ordered along each line
+// by chaining together nearest neighbours, starting from the warp furthest from the centre of the line.
+func inferRouteLines(companyProvider CompanyProvider, warps []Warp) []RouteLine {
+	lines := []RouteLine{}
+	warpsByLineID := map[string][]Warp{}
+	companiesByLineID := map[string]Company{}
+	lineIDs := []string{}
+
+	for _, warp := range warps {
+		company, exists := companyProvider.findCompanyForWarp(warp.Name)
+		if !exists {
+			continue
+		}
+
+		segment := strings.FieldsFunc(warp.Name, func(character rune) bool {
+			return character == '-' || character == '_' || character == ' '
+		})
+		if len(segment) == 0 {
+			continue
+		}
+
+		lineID := company.ID + ":" + segment[0]
+		if _, exists := warpsByLineID[lineID]; !exists {
+			lineIDs = append(lineIDs, lineID)
+			companiesByLineID[lineID] = company
+		}
+		warpsByLineID[lineID] = append(warpsByLineID[lineID], warp)
+	}
+
+	for _, lineID := range lineIDs {
+		lines = append(lines, RouteLine{
+			ID:      lineID,
+			Company: companiesByLineID[lineID],
+			Warps:   orderByNearestNeighbour(warpsByLineID[lineID]),
+		})
+	}
+
+	return lines
+}
+
+func orderByNearestNeighbour(warps []Warp) []Warp {
+	if len(warps) <= 2 {
+		return warps
+	}
+
+	centreX, centreZ := 0.0, 0.0
+	for _, warp := range warps {
+		centreX += warp.X
+		centreZ += warp.Z
+	}
+	centreX /= float64(len(warps))
+	centreZ /= float64(len(warps))
+
+	remaining := append([]Warp{}, warps...)
+
+	start := 0
+	for i := range remaining {
+		if math.Hypot(remaining[i].X-centreX, remaining[i].Z-centreZ) > math.Hypot(remaining[start].X-centreX, remaining[start].Z-centreZ) {
+			start = i
+		}
+	}
+
+	ordered := []Warp{remaining[start]}
+	remaining = append(remaining[:start], remaining[start+1:]...)
+
+	for len(remaining) > 0 {
+		last := ordered[len(ordered)-1]
+
+		next := 0
+		for i := range remaining {
+			if math.Hypot(remaining[i].X-last.X, remaining[i].Z-last.Z) < math.Hypot(remaining[next].X-last.X, remaining[next].Z-last.Z) {
+				next = i
+			}
+		}
+
+		ordered = append(ordered, remaining[next])
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+
+	return ordered
+}
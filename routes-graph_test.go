@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+// Routing between two warps that are already in the graph must not change the graph that the copy was cloned from,
+// since that graph is cached and shared by other requests.
+func TestCloneDoesNotChangeCachedGraph(t *testing.T) {
+	config := RoutingConfig{
+		MaxTransferDistance: 50,
+		WalkingSpeed:        4,
+		TransferPenalty:     30,
+		Speeds:              map[TransportMode]float64{"warp_rail": 100},
+	}
+
+	company := Company{ID: "IR", Mode: "warp_rail"}
+	lines := []RouteLine{
+		{ID: "IR1", Company: company, Warps: []Warp{{ID: 1, X: 0, Z: 0}, {ID: 2, X: 1000, Z: 0}}},
+		{ID: "IR2", Company: company, Warps: []Warp{{ID: 3, X: 0, Z: 2000}, {ID: 4, X: 1000, Z: 2000}}},
+	}
+
+	cachedGraph := newRouteGraph(config, lines)
+
+	edgeCounts := []int{}
+	for node := range cachedGraph.nodes {
+		edgeCounts = append(edgeCounts, len(cachedGraph.edges[node]))
+	}
+
+	graph := cachedGraph.clone(config)
+	source := graph.addRoutePoint(RoutePoint{Warp: &lines[0].Warps[0]})
+	target := graph.addRoutePoint(RoutePoint{Warp: &lines[1].Warps[0]})
+
+	if _, exists := graph.findEdge(source, target); exists {
+		t.Fatalf("expected no edge between warps %d and %d", source, target)
+	}
+	graph.addWalkEdges(source, target)
+
+	if _, exists := graph.findEdge(source, target); !exists {
+		t.Fatalf("expected the copy to have a walking edge between warps %d and %d", source, target)
+	}
+
+	if len(cachedGraph.nodes) != len(edgeCounts) {
+		t.Fatalf("expected the cached graph to have %d nodes, but it has %d", len(edgeCounts), len(cachedGraph.nodes))
+	}
+
+	for node, count := range edgeCounts {
+		if len(cachedGraph.edges[node]) != count {
+			t.Errorf("expected node %d of the cached graph to have %d edges, but it has %d", node, count, len(cachedGraph.edges[node]))
+		}
+	}
+
+	if _, exists := cachedGraph.findEdge(source, target); exists {
+		t.Errorf("expected the cached graph to have no edge between warps %d and %d", source, target)
+	}
+}
@@ -0,0 +1,483 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"gopkg.in/yaml.v3"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+const (
+	DEFAULT_ITINERARIES_LIMIT = 3
+	MAX_ITINERARIES_LIMIT     = 10
+)
+
+type RoutingConfig struct {
+	MaxTransferDistance float64 `yaml:"max_transfer_distance"`
+	WalkingSpeed        float64 `yaml:"walking_speed"`
+	TransferPenalty     float64 `yaml:"transfer_penalty"`
+
+	// Time (in minutes) that the route graph of each world is reused before it is rebuilt from the latest warps
+	GraphLifetime int `yaml:"graph_lifetime"`
+
+	// Only transport modes with a speed are included in the route graph
+	Speeds map[TransportMode]float64 `yaml:"speeds"`
+}
+
+type RoutePoint struct {
+	Warp *Warp   `json:"warp,omitempty"`
+	X    float64 `json:"x"`
+	Z    float64 `json:"z"`
+}
+
+type ItineraryLeg struct {
+	Type     RouteEdgeType  `json:"type"`
+	Company  *string        `json:"company,omitempty"`
+	Mode     *TransportMode `json:"mode,omitempty"`
+	Line     *string        `json:"line,omitempty"`
+	From     RoutePoint     `json:"from"`
+	To       RoutePoint     `json:"to"`
+	Stops    []Warp         `json:"stops,omitempty"`
+	Distance float64        `json:"distance"`
+	Duration float64        `json:"duration"`
+}
+
+type Itinerary struct {
+	Duration  float64        `json:"duration"`
+	Distance  float64        `json:"distance"`
+	Transfers int            `json:"transfers"`
+	Legs      []ItineraryLeg `json:"legs"`
+}
+
+type RouteResponse struct {
+	World       string      `json:"world"`
+	From        RoutePoint  `json:"from"`
+	To          RoutePoint  `json:"to"`
+	Itineraries []Itinerary `json:"itineraries"`
+}
+
+func (response RouteResponse) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type RouteProvider struct {
+	warpProvider WarpProviderV2
+	lineProvider LineProvider
+	config       RoutingConfig
+	graphs       *RouteGraphCache
+}
+
+// Route graphs of each world, which are reused until they expire or the data is reloaded
+type RouteGraphCache struct {
+	mutex   sync.Mutex
+	entries map[string]RouteGraphCacheEntry
+}
+
+type RouteGraphCacheEntry struct {
+	graph    *RouteGraph
+	snapshot *DataSnapshot
+	built    time.Time
+}
+
+func newRouteGraphCache() *RouteGraphCache {
+	return &RouteGraphCache{entries: map[string]RouteGraphCacheEntry{}}
+}
+
+// getRoutes   godoc
+// @summary     Plan routes between two locations
+// @description Find itineraries between two warps or coordinates, using the lines of companies with a transport mode that has a speed in the routing config, and walking transfers between nearby warps.
+// @description Lines are taken from /lines where defined, otherwise they are inferred from the names and locations of each company's warps.
+// @description Itineraries are ranked by estimated duration (in seconds), and distances are measured in blocks.
+// @tags        Routes
+// @produce     json
+// @param       from     query    string true  "Starting warp ID, or coordinates in the format 'x,z'."
+// @param       to       query    string true  "Destination warp ID, or coordinates in the format 'x,z'."
+// @param       world    query    string false "World ID (from /worlds). Required if both 'from' and 'to' are coordinates."
+// @param       max_walk query    number false "Maximum walking distance (in blocks) between two warps when transferring. Default and maximum is the max_transfer_distance of the routing config."
+// @param       limit    query    int    false "Maximum number of itineraries returned. Default is 3, maximum is 10."
+// @success     200      {object} RouteResponse
+// @failure     400      {object} Error
+// @router      /routes [get]
+func (provider RouteProvider) getRoutes(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	fromStr := request.URL.Query().Get("from")
+	toStr := request.URL.Query().Get("to")
+	worldID := request.URL.Query().Get("world")
+	maxWalkStr := request.URL.Query().Get("max_walk")
+	limitStr := request.URL.Query().Get("limit")
+
+	config := provider.config
+
+	if maxWalkStr != "" {
+		maxWalk, err := strconv.ParseFloat(maxWalkStr, 64)
+		if err != nil || maxWalk < 0 || maxWalk > provider.config.MaxTransferDistance {
+			detail := fmt.Sprintf("The 'max_walk' query parameter must be a number within the following range: 0 <= max_walk <= %g.", provider.config.MaxTransferDistance)
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		config.MaxTransferDistance = maxWalk
+	}
+
+	limit := DEFAULT_ITINERARIES_LIMIT
+
+	if limitStr != "" {
+		newLimit, err := strconv.Atoi(limitStr)
+		if err != nil || newLimit < 1 || newLimit > MAX_ITINERARIES_LIMIT {
+			detail := fmt.Sprintf("The 'limit' query parameter must be an integer within the following range: 1 <= limit <= %d.", MAX_ITINERARIES_LIMIT)
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		limit = newLimit
+	}
+
+	from, err := provider.parseRoutePoint(request.Context(), "from", fromStr)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	to, err := provider.parseRoutePoint(request.Context(), "to", toStr)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	world, err := provider.resolveRouteWorld(worldID, from, to)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	baseGraph, err := provider.getRouteGraph(request.Context(), world)
+	checkForErrors(err)
+
+	// Add the points to a copy, since the graph is shared with other requests
+	graph := baseGraph.clone(config)
+
+	source := graph.addRoutePoint(from)
+	target := graph.addRoutePoint(to)
+
+	// Always offer walking directly to the destination
+	if _, exists := graph.findEdge(source, target); !exists && source != target {
+		graph.addWalkEdges(source, target)
+	}
+
+	itineraries := []Itinerary{}
+	for _, path := range graph.findShortestPaths(source, target, limit) {
+		itineraries = append(itineraries, graph.buildItinerary(path))
+	}
+
+	response := RouteResponse{
+		World:       world.ID,
+		From:        from,
+		To:          to,
+		Itineraries: itineraries,
+	}
+
+	err = render.Render(writer, request, response)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// parseRoutePoint parses either a warp ID or coordinates in the format 'x,z'.
+func (provider RouteProvider) parseRoutePoint(ctx context.Context, name string, value string) (RoutePoint, error) {
+	invalid := fmt.Errorf("The '%s' query parameter must be either a warp ID, or coordinates in the format 'x,z'.", name)
+
+	if value == "" {
+		return RoutePoint{}, invalid
+	}
+
+	if strings.Contains(value, ",") {
+		parts := strings.Split(value, ",")
+		if len(parts) != 2 {
+			return RoutePoint{}, invalid
+		}
+
+		x, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		if err != nil {
+			return RoutePoint{}, invalid
+		}
+
+		z, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			return RoutePoint{}, invalid
+		}
+
+		return RoutePoint{X: x, Z: z}, nil
+	}
+
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return RoutePoint{}, invalid
+	}
+
+	warp, exists, err := provider.warpProvider.queryWarpById(ctx, uint32(id))
+	checkForErrors(err)
+
+	if !exists {
+		return RoutePoint{}, fmt.Errorf("The warp specified by the '%s' query parameter does not exist.", name)
+	}
+
+	return RoutePoint{Warp: &warp, X: warp.X, Z: warp.Z}, nil
+}
+
+// resolveRouteWorld returns the world specified by ID, or the world of the warps if no ID is specified.
+func (provider RouteProvider) resolveRouteWorld(worldID string, points ...RoutePoint) (World, error) {
+	worldProvider := provider.warpProvider.worldProvider()
+
+	var world World
+
+	if worldID != "" {
+		exists := false
+		world, exists = worldProvider.worldsByID.Get(worldID)
+		if !exists {
+			return World{}, errors.New("The 'world' query parameter must be one of the IDs returned from the /worlds endpoint.")
+		}
+	}
+
+	for _, point := range points {
+		if point.Warp == nil {
+			continue
+		}
+
+		if world.ID == "" {
+			exists := false
+			world, exists = worldProvider.findWorldByUUID(point.Warp.WorldUUID)
+			if !exists {
+				return World{}, errors.New("The warps must be in one of the worlds returned from the /worlds endpoint.")
+			}
+		}
+
+		if point.Warp.WorldUUID != world.UUID {
+			return World{}, errors.New("The 'from' and 'to' warps must be in the same world.")
+		}
+	}
+
+	if world.ID == "" {
+		return World{}, errors.New("The 'world' query parameter is required if both 'from' and 'to' are coordinates.")
+	}
+
+	return world, nil
+}
+
+// getRouteGraph returns the route graph of the world, which is built with the maximum transfer distance of the routing config.
+// The graph is only rebuilt once it is older than the graph lifetime, or if the data has been reloaded since it was built.
+func (provider RouteProvider) getRouteGraph(ctx context.Context, world World) (*RouteGraph, error) {
+	snapshot := provider.warpProvider.dataSnapshot()
+	lifetime := time.Duration(provider.config.GraphLifetime) * time.Minute
+
+	// Only build one graph at a time, so that concurrent requests wait for the graph instead of building it again
+	provider.graphs.mutex.Lock()
+	defer provider.graphs.mutex.Unlock()
+
+	entry, exists := provider.graphs.entries[world.ID]
+	if exists && entry.snapshot == snapshot && time.Since(entry.built) < lifetime {
+		return entry.graph, nil
+	}
+
+	lines, err := provider.queryRouteLines(ctx, world)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := newRouteGraph(provider.config, lines)
+	provider.graphs.entries[world.ID] = RouteGraphCacheEntry{
+		graph:    graph,
+		snapshot: snapshot,
+		built:    time.Now(),
+	}
+
+	return graph, nil
+}
+
+// queryRouteLines returns the lines of all companies with a routable transport mode in the world.
+// Lines defined in lines.yml are used where available, otherwise lines are inferred from the company's warps.
+func (provider RouteProvider) queryRouteLines(ctx context.Context, world World) ([]RouteLine, error) {
+	companiesByMode := provider.warpProvider.companyProvider().companiesByMode
+
+	orExpressions := []BoolExpression{}
+	for pair := companiesByMode.Oldest(); pair != nil; pair = pair.Next() {
+		if !provider.isRouteTransportMode(pair.Key) {
+			continue
+		}
+
+		for _, company := range pair.Value {
+			orExpressions = append(orExpressions, company.warpCondition())
+		}
+	}
+
+	if len(orExpressions) == 0 {
+		return []RouteLine{}, nil
+	}
+
+	condition := AND(
+		table.World.UUID.EQ(String(world.UUID)),
+		OR(orExpressions...),
+	)
+
+	warps := []Warp{}
+	err := provider.warpProvider.streamWarps(ctx, condition, table.Warp.WarpID.ASC(), func(warp Warp) error {
+		company, exists := provider.warpProvider.companyProvider().findCompanyForWarp(warp.Name)
+		if exists && provider.isRouteTransportMode(company.Mode) {
+			warps = append(warps, warp)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []RouteLine{}
+	definedCompanyIDs := map[string]bool{}
+
+	for _, line := range provider.lineProvider.lines {
+		if line.World != "" && line.World != world.ID {
+			continue
+		}
+
+		company, _ := provider.warpProvider.companyProvider().companiesByID.Get(line.Company)
+		if !provider.isRouteTransportMode(company.Mode) {
+			continue
+		}
+
+		lines = append(lines, line.toRouteLine(company, warps))
+		definedCompanyIDs[company.ID] = true
+	}
+
+	undefinedWarps := []Warp{}
+	for _, warp := range warps {
+		company, _ := provider.warpProvider.companyProvider().findCompanyForWarp(warp.Name)
+		if !definedCompanyIDs[company.ID] {
+			undefinedWarps = append(undefinedWarps, warp)
+		}
+	}
+
+	return append(lines, inferRouteLines(provider.warpProvider.companyProvider(), undefinedWarps)...), nil
+}
+
+func (provider RouteProvider) isRouteTransportMode(mode TransportMode) bool {
+	_, exists := provider.config.Speeds[mode]
+	return exists
+}
+
+// addRoutePoint returns the node of the warp if it is already in the graph, or adds a new node for the point.
+func (graph *RouteGraph) addRoutePoint(point RoutePoint) int {
+	if point.Warp != nil {
+		if index, exists := graph.nodesByWarpID[point.Warp.ID]; exists {
+			return index
+		}
+	}
+
+	return graph.addPointNode(RouteNode{Warp: point.Warp, X: point.X, Z: point.Z})
+}
+
+func (graph *RouteGraph) routePoint(node int) RoutePoint {
+	return RoutePoint{
+		Warp: graph.nodes[node].Warp,
+		X:    graph.nodes[node].X,
+		Z:    graph.nodes[node].Z,
+	}
+}
+
+// buildItinerary combines consecutive edges of the same line (or consecutive walks) into legs.
+func (graph *RouteGraph) buildItinerary(path RoutePath) Itinerary {
+	itinerary := Itinerary{
+		Duration: path.Cost,
+		Legs:     []ItineraryLeg{},
+	}
+
+	var leg *ItineraryLeg
+	previousLine := -1
+
+	for i := 0; i < len(path.Nodes)-1; i++ {
+		from, to := path.Nodes[i], path.Nodes[i+1]
+		edge, _ := graph.findEdge(from, to)
+
+		itinerary.Distance += edge.Distance
+
+		continuesLeg := leg != nil && leg.Type == edge.Type && (edge.Type == WalkEdge || edge.Line == previousLine)
+
+		if !continuesLeg {
+			itinerary.Legs = append(itinerary.Legs, ItineraryLeg{
+				Type: edge.Type,
+				From: graph.routePoint(from),
+			})
+			leg = &itinerary.Legs[len(itinerary.Legs)-1]
+
+			if edge.Type == RideEdge {
+				line := graph.lines[edge.Line]
+				leg.Company = &line.Company.ID
+				leg.Mode = &line.Company.Mode
+				leg.Line = &line.ID
+				leg.Stops = []Warp{*graph.nodes[from].Warp}
+			}
+		}
+
+		leg.To = graph.routePoint(to)
+		leg.Distance += edge.Distance
+		leg.Duration += edge.Cost
+
+		if edge.Type == RideEdge {
+			leg.Stops = append(leg.Stops, *graph.nodes[to].Warp)
+		}
+
+		previousLine = edge.Line
+	}
+
+	rides := 0
+	for _, leg := range itinerary.Legs {
+		if leg.Type == RideEdge {
+			rides++
+		}
+	}
+
+	if rides > 1 {
+		itinerary.Transfers = rides - 1
+	}
+
+	return itinerary
+}
+
+func routesRouter(provider RouteProvider) http.Handler {
+	router := chi.NewRouter()
+	router.Get("/", provider.getRoutes)
+	return router
+}
+
+func loadRoutingConfig() RoutingConfig {
+	config := RoutingConfig{}
+
+	data, err := os.ReadFile(ROUTING_CONFIG_PATH)
+	checkForErrors(err)
+
+	err = yaml.Unmarshal([]byte(data), &config)
+	checkForErrors(err)
+
+	if config.WalkingSpeed <= 0 {
+		panic("The routing config must have a positive walking speed")
+	}
+
+	if config.GraphLifetime <= 0 {
+		panic("The routing config must have a positive graph lifetime")
+	}
+
+	return config
+}
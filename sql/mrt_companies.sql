@@ -0,0 +1,26 @@
+-- Tables for storing companies in the MyWarp database, used when the admin API is enabled in config/admin_config.yml.
+-- These tables are not used by MyWarp itself.
+
+CREATE TABLE IF NOT EXISTS `mrt_company` (
+  `company_id` VARCHAR(64) NOT NULL,
+  -- Companies are matched against warps in ascending order of position
+  `position` INT UNSIGNED NOT NULL,
+  -- Company as a JSON object, in the same format as returned by /companies/{id}
+  `data` TEXT NOT NULL,
+  PRIMARY KEY (`company_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `mrt_company_audit` (
+  `audit_id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `company_id` VARCHAR(64) NOT NULL,
+  -- 'create', 'update', or 'delete'
+  `action` VARCHAR(16) NOT NULL,
+  -- Name of the operator who made the change, from config/admin_config.yml
+  `actor` VARCHAR(64) NOT NULL,
+  -- Company as a JSON object before and after the change, or NULL if it did not exist
+  `previous` TEXT NULL,
+  `current` TEXT NULL,
+  `created_at` DATETIME NOT NULL,
+  PRIMARY KEY (`audit_id`),
+  INDEX `mrt_company_audit_company_id` (`company_id`)
+);
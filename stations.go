@@ -0,0 +1,401 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+	"gopkg.in/yaml.v3"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+type StationsConfig struct {
+	Radius   float64
+	Diameter float64
+	Interval int
+}
+
+type Station struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	World     string          `json:"world"`
+	X         float64         `json:"x"`
+	Z         float64         `json:"z"`
+	Companies []string        `json:"companies"`
+	Modes     []TransportMode `json:"modes"`
+	WarpIDs   []uint32        `json:"warpIds"`
+}
+
+func (station Station) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type StationDetail struct {
+	Station
+	Warps []Warp `json:"warps"`
+}
+
+func (detail StationDetail) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// Result of a single run of the station clustering job
+type StationIndex struct {
+	stations     []StationDetail
+	stationsByID *orderedmap.OrderedMap[string, StationDetail]
+}
+
+type StationProvider struct {
+	warpProvider WarpProviderV2
+	config       StationsConfig
+
+	// Latest result of the clustering job, or nil if the job has not completed yet
+	index *atomic.Pointer[StationIndex]
+}
+
+// getStations  godoc
+// @summary     List all stations
+// @description List all stations. Stations are clusters of company warps in the same world that are close to each other, and are recalculated periodically.
+// @tags        Stations
+// @produce     json
+// @param       world   query    string false "Filter by world ID (from /worlds)."
+// @param       company query    string false "Filter by company ID (from /companies)."
+// @param       mode    query    string false "Filter by transport mode ID (from /modes)."
+// @success     200     {array}  Station
+// @failure     400     {object} Error
+// @failure     503     {object} Error
+// @router      /stations [get]
+func (provider StationProvider) getStations(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	worldID := request.URL.Query().Get("world")
+	companyID := request.URL.Query().Get("company")
+	mode := request.URL.Query().Get("mode")
+
+	index := provider.index.Load()
+	if index == nil {
+		render.Render(writer, request, ErrorServiceUnavailable)
+		return
+	}
+
+	if worldID != "" {
+		if _, exists := provider.warpProvider.worldProvider().worldsByID.Get(worldID); !exists {
+			detail := "The 'world' query parameter must be one of the IDs returned from the /worlds endpoint."
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+	}
+
+	if companyID != "" {
+		if _, exists := provider.warpProvider.companyProvider().companiesByID.Get(companyID); !exists {
+			detail := "The 'company' query parameter must be one of the IDs returned from the /companies endpoint."
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+	}
+
+	if mode != "" {
+		if _, exists := provider.warpProvider.companyProvider().companiesByMode.Get(TransportMode(mode)); !exists {
+			detail := provider.warpProvider.modeProvider().invalidModeMessage("mode")
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+	}
+
+	stations := []Station{}
+	for _, station := range index.stations {
+		if worldID != "" && station.World != worldID {
+			continue
+		}
+
+		if companyID != "" && !containsString(station.Companies, companyID) {
+			continue
+		}
+
+		if mode != "" && !containsString(station.Modes, TransportMode(mode)) {
+			continue
+		}
+
+		stations = append(stations, station.Station)
+	}
+
+	err := render.RenderList(writer, request, toRenderList(stations))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getStationById godoc
+// @summary       Get station by ID
+// @description   Get station by ID, including all of its warps.
+// @tags          Stations
+// @produce       json
+// @param         id  path     string true "Station ID"
+// @success       200 {object} StationDetail
+// @failure       404 {object} Error
+// @failure       503 {object} Error
+// @router        /stations/{id} [get]
+func (provider StationProvider) getStationById(writer http.ResponseWriter, request *http.Request) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+
+	index := provider.index.Load()
+	if index == nil {
+		render.Render(writer, request, ErrorServiceUnavailable)
+		return
+	}
+
+	station, exists := index.stationsByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	err := render.Render(writer, request, station)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// runClusteringJob recalculates the stations immediately, and then again at every interval.
+// If a run fails, the stations from the previous run are kept.
+func (provider StationProvider) runClusteringJob() {
+	ticker := time.NewTicker(time.Duration(provider.config.Interval) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+
+		index, err := provider.clusterStations(context.Background())
+		if err != nil {
+			log.Println("Station clustering failed: ", err)
+		} else {
+			provider.index.Store(index)
+			log.Printf("Station clustering found %d stations in %s", len(index.stations), time.Since(start))
+		}
+
+		<-ticker.C
+	}
+}
+
+// clusterStations groups the company warps of each world into stations.
+func (provider StationProvider) clusterStations(ctx context.Context) (*StationIndex, error) {
+	provider.warpProvider = provider.warpProvider.withSnapshot()
+
+	companyProvider := provider.warpProvider.companyProvider()
+
+	index := &StationIndex{
+		stations:     []StationDetail{},
+		stationsByID: orderedmap.New[string, StationDetail](),
+	}
+
+	orExpressions := []BoolExpression{}
+	for _, company := range companyProvider.companies {
+		orExpressions = append(orExpressions, company.warpCondition())
+	}
+
+	if len(orExpressions) == 0 {
+		return index, nil
+	}
+
+	for _, world := range provider.warpProvider.worldProvider().worlds {
+		condition := AND(
+			table.World.UUID.EQ(String(world.UUID)),
+			OR(orExpressions...),
+		)
+
+		warps := []Warp{}
+		err := provider.warpProvider.streamWarps(ctx, condition, table.Warp.WarpID.ASC(), func(warp Warp) error {
+			warps = append(warps, warp)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		points := []Point{}
+		for _, warp := range warps {
+			points = append(points, Point{warp.X, warp.Z})
+		}
+
+		for _, cluster := range clusterPoints(points, provider.config.Radius, provider.config.Diameter) {
+			members := []Warp{}
+			for _, i := range cluster {
+				members = append(members, warps[i])
+			}
+
+			station := provider.buildStation(world, members)
+
+			// Stations that round to the same position are very unlikely, but their IDs must still be unique
+			id := station.ID
+			for suffix := 2; ; suffix++ {
+				if _, exists := index.stationsByID.Get(station.ID); !exists {
+					break
+				}
+				station.ID = fmt.Sprintf("%s.%d", id, suffix)
+			}
+
+			index.stations = append(index.stations, station)
+			index.stationsByID.Set(station.ID, station)
+		}
+	}
+
+	return index, nil
+}
+
+func (provider StationProvider) buildStation(world World, warps []Warp) StationDetail {
+	companyProvider := provider.warpProvider.companyProvider()
+
+	station := Station{
+		Name:      nameStation(warps),
+		World:     world.ID,
+		Companies: []string{},
+		Modes:     []TransportMode{},
+		WarpIDs:   []uint32{},
+	}
+
+	for _, warp := range warps {
+		station.X += warp.X / float64(len(warps))
+		station.Z += warp.Z / float64(len(warps))
+		station.WarpIDs = append(station.WarpIDs, warp.ID)
+
+		company, exists := companyProvider.findCompanyForWarp(warp.Name)
+		if !exists {
+			continue
+		}
+
+		if !containsString(station.Companies, company.ID) {
+			station.Companies = append(station.Companies, company.ID)
+		}
+
+		if !containsString(station.Modes, company.Mode) {
+			station.Modes = append(station.Modes, company.Mode)
+		}
+	}
+
+	// The ID is based on the position rather than on any single warp, so it stays the same when warps are added to or removed from the station
+	station.ID = fmt.Sprintf("%s.%d.%d", world.ID, int(math.Round(station.X)), int(math.Round(station.Z)))
+
+	return StationDetail{
+		Station: station,
+		Warps:   warps,
+	}
+}
+
+// nameStation picks the most common segment of the warp names, ignoring the first segment (which is usually a company or line code).
+// For example, "IR1-Central-N" and "WZR-Central-1" are named "Central".
+// If no such segment exists, the name of the most visited warp is used instead.
+func nameStation(warps []Warp) string {
+	counts := map[string]int{}
+	visits := map[string]uint32{}
+
+	mostVisited := warps[0]
+
+	for _, warp := range warps {
+		if warp.Visits > mostVisited.Visits {
+			mostVisited = warp
+		}
+
+		segments := strings.FieldsFunc(warp.Name, func(character rune) bool {
+			return character == '-' || character == '_'
+		})
+
+		if len(segments) > 0 {
+			segments = segments[1:]
+		}
+
+		seen := map[string]bool{}
+		for _, segment := range segments {
+			// Ignore short segments such as platform numbers and directions
+			if len(segment) < 3 || seen[segment] {
+				continue
+			}
+
+			seen[segment] = true
+			counts[segment]++
+			visits[segment] += warp.Visits
+		}
+	}
+
+	if len(counts) == 0 {
+		return mostVisited.Name
+	}
+
+	names := []string{}
+	for name := range counts {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(a, b int) bool {
+		if counts[names[a]] != counts[names[b]] {
+			return counts[names[a]] > counts[names[b]]
+		}
+		if visits[names[a]] != visits[names[b]] {
+			return visits[names[a]] > visits[names[b]]
+		}
+		return names[a] < names[b]
+	})
+
+	return names[0]
+}
+
+func containsString[S ~string](slice []S, value S) bool {
+	for _, element := range slice {
+		if element == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stationsRouter(provider StationProvider) http.Handler {
+	router := chi.NewRouter()
+	router.Get("/", provider.getStations)
+
+	router.Route("/{id}", func(subrouter chi.Router) {
+		subrouter.Get("/", provider.getStationById)
+	})
+
+	return router
+}
+
+func loadStations(warpProvider WarpProviderV2) StationProvider {
+	config := StationsConfig{}
+
+	data, err := os.ReadFile(STATIONS_CONFIG_PATH)
+	checkForErrors(err)
+
+	err = yaml.Unmarshal([]byte(data), &config)
+	checkForErrors(err)
+
+	if config.Interval <= 0 {
+		panic("The stations config must have a positive interval")
+	}
+
+	if config.Diameter < config.Radius {
+		panic("The stations config must have a diameter that is at least the radius")
+	}
+
+	return StationProvider{
+		warpProvider: warpProvider,
+		config:       config,
+		index:        &atomic.Pointer[StationIndex]{},
+	}
+}
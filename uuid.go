@@ -1,8 +1,21 @@
 package main
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
 
 func isValidUUID(u string) bool {
 	_, err := uuid.Parse(u)
 	return err == nil
 }
+
+// normalizePlayerUUID adds hyphens to the UUID if they are missing, and returns false if it is not a valid UUID.
+func normalizePlayerUUID(u string) (string, bool) {
+	if len(u) == 32 {
+		u = fmt.Sprintf("%s-%s-%s-%s-%s", u[0:8], u[8:12], u[12:16], u[16:20], u[20:32])
+	}
+
+	return u, isValidUUID(u)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"math"
 	"net/http"
 	"time"
 
@@ -27,6 +28,31 @@ type Warp struct {
 	Type           uint8     `json:"type"`
 	Visits         uint32    `json:"visits"`
 	WelcomeMessage *string   `json:"welcomeMessage"`
+
+	// The following fields are only set by v2, so that the v1 response stays the same
+
+	// Location of the warp on the web map of its world, if the world has a map URL template
+	MapURL string `json:"mapUrl,omitempty"`
+
+	// Chunk and region file that contain the warp (omitted entirely if not set)
+	*WarpGridLocation
+
+	// IDs of the areas (such as cities) that the warp lies in
+	Areas []string `json:"areas,omitempty"`
+}
+
+type WarpGridLocation struct {
+	// Chunk and region file that contain the warp in its own world, calculated from its original coordinates (before any 'coords' conversion)
+	ChunkX  int `json:"chunkX"`
+	ChunkZ  int `json:"chunkZ"`
+	RegionX int `json:"regionX"`
+	RegionZ int `json:"regionZ"`
+}
+
+// blockCoordinates returns the coordinates of the block that the warp is in.
+// Coordinates are rounded down rather than truncated, so that negative coordinates are in the correct block.
+func (warp Warp) blockCoordinates() (int, int, int) {
+	return int(math.Floor(warp.X)), int(math.Floor(warp.Y)), int(math.Floor(warp.Z))
 }
 
 func (warp Warp) Render(writer http.ResponseWriter, request *http.Request) error {
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+)
+
+var warpCSVHeader = []string{
+	"id",
+	"name",
+	"playerUUID",
+	"worldUUID",
+	"x",
+	"y",
+	"z",
+	"pitch",
+	"yaw",
+	"creationDate",
+	"type",
+	"visits",
+	"welcomeMessage",
+	"mapUrl",
+	"chunkX",
+	"chunkZ",
+	"regionX",
+	"regionZ",
+	"areas",
+}
+
+func warpToCSVRecord(warp Warp) []string {
+	welcomeMessage := ""
+	if warp.WelcomeMessage != nil {
+		welcomeMessage = *warp.WelcomeMessage
+	}
+
+	return []string{
+		strconv.FormatUint(uint64(warp.ID), 10),
+		warp.Name,
+		warp.PlayerUUID,
+		warp.WorldUUID,
+		strconv.FormatFloat(warp.X, 'f', -1, 64),
+		strconv.FormatFloat(warp.Y, 'f', -1, 64),
+		strconv.FormatFloat(warp.Z, 'f', -1, 64),
+		strconv.FormatFloat(warp.Pitch, 'f', -1, 64),
+		strconv.FormatFloat(warp.Yaw, 'f', -1, 64),
+		warp.CreationDate.Format(time.RFC3339),
+		strconv.FormatUint(uint64(warp.Type), 10),
+		strconv.FormatUint(uint64(warp.Visits), 10),
+		welcomeMessage,
+		warp.MapURL,
+		strconv.Itoa(warp.ChunkX),
+		strconv.Itoa(warp.ChunkZ),
+		strconv.Itoa(warp.RegionX),
+		strconv.Itoa(warp.RegionZ),
+		strings.Join(warp.Areas, ";"),
+	}
+}
+
+// exportWarps  godoc
+// @summary     Export all warps
+// @description Export all warps as newline-delimited JSON or CSV. Unlike /warps, there is no limit on the number of warps returned. Rows are streamed directly from the database as they are read.
+// @tags        Warps
+// @produce     application/x-ndjson
+// @produce     text/csv
+// @param       format         query    string false "Export format: 'ndjson' (default) or 'csv'."
+// @param       name           query    string false "Filter by warp name."
+// @param       player         query    string false "Filter by player UUID (can be with or without hyphens)."
+// @param       company        query    string false "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company."
+// @param       include_legacy query    bool   false "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter."
+// @param       alliance       query    string false "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance."
+// @param       mode           query    string false "Filter by transport mode ID (from /modes)."
+// @param       world          query    string false "Filter by world ID (from /worlds)."
+// @param       type           query    int    false "Filter by type (0 = private, 1 = public)."
+// @param       region         query    string false "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files."
+// @param       chunk          query    string false "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40')."
+// @param       area           query    string false "Filter by area ID (from /areas), matching the warps that lie within the area."
+// @param       order_by       query    string false "Order by 'name', 'creation_date', or 'visits'."
+// @param       sort_by        query    string false "Sort by 'asc' (ascending) or 'desc' (descending)."
+// @param       coords         query    string false "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8)."
+// @success     200            {array}  Warp
+// @failure     400            {object} Error
+// @router      /warps/export [get]
+func (provider WarpProviderV2) exportWarps(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	format := request.URL.Query().Get("format")
+	parameters := warpQueryParametersFromRequest(request)
+
+	if format != "" && format != "ndjson" && format != "csv" {
+		detail := "The 'format' query parameter must be one of 'ndjson' or 'csv'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	condition, err := provider.buildWarpCondition(parameters)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	orderByClause, err := buildWarpOrderByClause(parameters)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	coords, err := parseCoordsParameter(parameters.Coords)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	var writeWarp func(warp Warp) error
+
+	if format == "csv" {
+		writer.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		writer.Header().Set("Content-Disposition", `attachment; filename="warps.csv"`)
+
+		csvWriter := csv.NewWriter(writer)
+		defer csvWriter.Flush()
+
+		err = csvWriter.Write(warpCSVHeader)
+		checkForErrors(err)
+
+		writeWarp = func(warp Warp) error {
+			return csvWriter.Write(warpToCSVRecord(warp))
+		}
+	} else {
+		writer.Header().Set("Content-Type", "application/x-ndjson")
+
+		encoder := json.NewEncoder(writer)
+		encoder.SetEscapeHTML(false)
+
+		writeWarp = func(warp Warp) error {
+			return encoder.Encode(warp)
+		}
+	}
+
+	err = provider.streamWarps(request.Context(), condition, orderByClause, func(warp Warp) error {
+		provider.convertWarpCoordinates(&warp, coords)
+		return writeWarp(warp)
+	})
+	if err != nil {
+		// The response status has already been sent, so abort the response to signal that the export is incomplete
+		log.Println("Warp export aborted: ", err)
+		panic(http.ErrAbortHandler)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const GEOJSON_CONTENT_TYPE = "application/geo+json"
+
+type GeoJSONFeatureCollection struct {
+	Type       string                 `json:"type"`
+	Features   []GeoJSONFeature       `json:"features"`
+	Pagination WarpResponsePagination `json:"pagination"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                `json:"type"`
+	ID         uint32                `json:"id"`
+	Geometry   GeoJSONGeometry       `json:"geometry"`
+	Properties GeoJSONWarpProperties `json:"properties"`
+}
+
+type GeoJSONGeometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+type GeoJSONWarpProperties struct {
+	Name    string  `json:"name"`
+	Company *string `json:"company"`
+	World   *string `json:"world"`
+	Visits  uint32  `json:"visits"`
+}
+
+// wantsGeoJSON returns true if the request asks for GeoJSON, either with the 'format' query parameter or the Accept header.
+// An explicit 'format' takes precedence, so that 'json' returns plain JSON regardless of the Accept header.
+func wantsGeoJSON(request *http.Request) bool {
+	if format := request.URL.Query().Get("format"); format != "" {
+		return format == "geojson"
+	}
+
+	return strings.Contains(request.Header.Get("Accept"), GEOJSON_CONTENT_TYPE)
+}
+
+// warpsToGeoJSON converts warps into a feature collection of points at (x, z).
+// Coordinates from different worlds are not comparable, so use the 'world' filter when displaying the result on a map.
+func (provider WarpProviderV2) warpsToGeoJSON(response WarpResponse) GeoJSONFeatureCollection {
+	features := []GeoJSONFeature{}
+
+	for _, warp := range response.Result {
+		properties := GeoJSONWarpProperties{
+			Name:   warp.Name,
+			Visits: warp.Visits,
+		}
+
+		if company, exists := provider.companyProvider().findCompanyForWarp(warp.Name); exists {
+			properties.Company = &company.ID
+		}
+
+		if world, exists := provider.worldProvider().findWorldByUUID(warp.WorldUUID); exists {
+			properties.World = &world.ID
+		}
+
+		features = append(features, GeoJSONFeature{
+			Type: "Feature",
+			ID:   warp.ID,
+			Geometry: GeoJSONGeometry{
+				Type:        "Point",
+				Coordinates: []float64{warp.X, warp.Z},
+			},
+			Properties: properties,
+		})
+	}
+
+	return GeoJSONFeatureCollection{
+		Type:       "FeatureCollection",
+		Features:   features,
+		Pagination: response.Pagination,
+	}
+}
+
+func writeGeoJSON(writer http.ResponseWriter, collection GeoJSONFeatureCollection) error {
+	writer.Header().Set("Content-Type", GEOJSON_CONTENT_TYPE)
+	return json.NewEncoder(writer).Encode(collection)
+}
@@ -15,17 +15,17 @@ import (
 )
 
 type WarpProviderV1 struct {
-	db              *sql.DB
-	companyProvider CompanyProvider
-	worldProvider   WorldProvider
+	db   *sql.DB
+	data *DataStore
 }
 
 func (provider WarpProviderV1) getWarps(writer http.ResponseWriter, request *http.Request) {
 	warps := []Warp{}
 
 	db := provider.db
-	companiesByID := provider.companyProvider.companiesByID
-	worldsByID := provider.worldProvider.worldsByID
+	snapshot := provider.data.snapshot.Load()
+	companiesByID := snapshot.companyProvider.companiesByID
+	worldsByID := snapshot.worldProvider.worldsByID
 
 	name := request.URL.Query().Get("name")
 	playerUUID := request.URL.Query().Get("player")
@@ -74,7 +74,7 @@ func (provider WarpProviderV1) getWarps(writer http.ResponseWriter, request *htt
 			return
 		}
 
-		boolExpressions = append(boolExpressions, table.Warp.Name.LIKE(String(company.Pattern)))
+		boolExpressions = append(boolExpressions, company.warpCondition())
 	}
 
 	// Filter by world
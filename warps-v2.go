@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -35,104 +37,349 @@ func (pagination WarpResponsePagination) Render(writer http.ResponseWriter, requ
 }
 
 type WarpProviderV2 struct {
-	db              *sql.DB
-	companyProvider CompanyProvider
-	worldProvider   WorldProvider
+	db   *sql.DB
+	data *DataStore
+
+	// If not nil, the data used by a single request or operation, so that it stays consistent even if the data is reloaded in the meantime
+	snapshot *DataSnapshot
+}
+
+// withSnapshot returns a copy of the provider that keeps using the data that is current now.
+// Call this at the start of each request or operation, and do not store the copy for later ones.
+func (provider WarpProviderV2) withSnapshot() WarpProviderV2 {
+	if provider.snapshot == nil {
+		provider.snapshot = provider.data.snapshot.Load()
+	}
+	return provider
+}
+
+// dataSnapshot returns the data used by the provider, which is the current data if the provider does not have a snapshot.
+func (provider WarpProviderV2) dataSnapshot() *DataSnapshot {
+	if provider.snapshot != nil {
+		return provider.snapshot
+	}
+	return provider.data.snapshot.Load()
+}
+
+func (provider WarpProviderV2) modeProvider() ModeProvider {
+	return provider.dataSnapshot().modeProvider
+}
+
+func (provider WarpProviderV2) companyProvider() CompanyProvider {
+	return provider.dataSnapshot().companyProvider
+}
+
+func (provider WarpProviderV2) allianceProvider() AllianceProvider {
+	return provider.dataSnapshot().allianceProvider
+}
+
+func (provider WarpProviderV2) worldProvider() WorldProvider {
+	return provider.dataSnapshot().worldProvider
+}
+
+func (provider WarpProviderV2) areaProvider() AreaProvider {
+	return provider.dataSnapshot().areaProvider
 }
 
 // getWarps godoc
 // @summary     List all warps
 // @description List all warps. Maximum number of warps returned per request is 2000. Use the 'offset' query parameter to show further entries.
+// @description Set 'format' to 'geojson' (or the Accept header to 'application/geo+json') to return a GeoJSON FeatureCollection of points at (x, z) instead. An explicit 'format' takes precedence over the Accept header. Coordinates are only comparable within the same world, so combine this with the 'world' filter.
 // @tags        Warps
 // @produce     json
-// @param       name     query    string false "Filter by warp name."
-// @param       player   query    string false "Filter by player UUID (can be with or without hyphens)."
-// @param       company  query    string false "Filter by company ID (from /companies)."
-// @param       mode     query    string false "Filter by transport mode: `warp_rail`, `bus`, `air`, `sea`, or `other`."
-// @param       world    query    string false "Filter by world ID (from /worlds)."
-// @param       type     query    int    false "Filter by type (0 = private, 1 = public)."
-// @param       order_by query    string false "Order by 'name', 'creation_date', or 'visits'."
-// @param       sort_by  query    string false "Sort by 'asc' (ascending) or 'desc' (descending)."
-// @param       limit    query    int    false "Limit number of warps returned. Maximum limit is 2000."
-// @param       offset   query    int    false "Number of warps to skip before returning."
-// @success     200      {object} WarpResponse
-// @failure     400      {object} Error
+// @produce     application/geo+json
+// @param       format         query    string false "Response format: 'json' (default) or 'geojson'."
+// @param       name           query    string false "Filter by warp name."
+// @param       player         query    string false "Filter by player UUID (can be with or without hyphens)."
+// @param       company        query    string false "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company."
+// @param       include_legacy query    bool   false "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter."
+// @param       alliance       query    string false "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance."
+// @param       mode           query    string false "Filter by transport mode ID (from /modes)."
+// @param       world          query    string false "Filter by world ID (from /worlds)."
+// @param       type           query    int    false "Filter by type (0 = private, 1 = public)."
+// @param       region         query    string false "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files."
+// @param       chunk          query    string false "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40')."
+// @param       area           query    string false "Filter by area ID (from /areas), matching the warps that lie within the area."
+// @param       order_by       query    string false "Order by 'name', 'creation_date', or 'visits'."
+// @param       sort_by        query    string false "Sort by 'asc' (ascending) or 'desc' (descending)."
+// @param       limit          query    int    false "Limit number of warps returned. Maximum limit is 2000."
+// @param       offset         query    int    false "Number of warps to skip before returning."
+// @param       coords         query    string false "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8)."
+// @success     200            {object} WarpResponse
+// @failure     400            {object} Error
 // @router      /warps [get]
 func (provider WarpProviderV2) getWarps(writer http.ResponseWriter, request *http.Request) {
-	warps := []Warp{}
+	provider = provider.withSnapshot()
 
-	db := provider.db
-	companiesByID := provider.companyProvider.companiesByID
-	companiesByMode := provider.companyProvider.companiesByMode
-	worldsByID := provider.worldProvider.worldsByID
+	provider.renderWarps(writer, request, warpQueryParametersFromRequest(request))
+}
 
-	name := request.URL.Query().Get("name")
-	playerUUID := request.URL.Query().Get("player")
-	companyID := request.URL.Query().Get("company")
-	mode := request.URL.Query().Get("mode")
-	worldID := request.URL.Query().Get("world")
-	typeStr := request.URL.Query().Get("type")
+// renderWarps renders a single page of the warps matching the parameters, in the format requested by the 'format' query parameter or Accept header.
+// Used by /warps, and by the routes that list the warps of a single company, world or player.
+func (provider WarpProviderV2) renderWarps(writer http.ResponseWriter, request *http.Request, parameters WarpQueryParameters) {
+	format := request.URL.Query().Get("format")
 
-	orderBy := request.URL.Query().Get("order_by")
-	sortBy := request.URL.Query().Get("sort_by")
+	if format != "" && format != "json" && format != "geojson" {
+		detail := "The 'format' query parameter must be one of 'json' or 'geojson'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
 
-	limitStr := request.URL.Query().Get("limit")
-	offsetStr := request.URL.Query().Get("offset")
+	condition, err := provider.buildWarpCondition(parameters)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
 
-	selectStatement := beginWarpSelectStatement()
-	countStatement := beginWarpCountStatement()
+	orderByClause, err := buildWarpOrderByClause(parameters)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	coords, err := parseCoordsParameter(parameters.Coords)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	limit, offset, err := buildWarpPagination(parameters)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	response, err := provider.queryWarps(request.Context(), condition, orderByClause, limit, offset)
+	checkForErrors(err)
+
+	for i := range response.Result {
+		provider.convertWarpCoordinates(&response.Result[i], coords)
+	}
+
+	if wantsGeoJSON(request) {
+		err = writeGeoJSON(writer, provider.warpsToGeoJSON(response))
+		checkForErrors(err)
+		return
+	}
+
+	err = render.Render(writer, request, response)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// getWarpById  godoc
+// @summary     Get warp by ID
+// @description Get warp by ID.
+// @tags        Warps
+// @produce     json
+// @param       id  path     int   true "Warp ID"
+// @success     200 {object} Warp
+// @failure     400 {object} Error
+// @failure     404 {object} Error
+// @router      /warps/{id} [get]
+func (provider WarpProviderV2) getWarpById(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	idStr := chi.URLParam(request, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		detail := "The 'id' parameter must be an unsigned integer."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	warp, exists, err := provider.queryWarpById(request.Context(), uint32(id))
+	checkForErrors(err)
+
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	err = render.Render(writer, request, warp)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+func warpsRouterV2(provider WarpProviderV2) http.Handler {
+	router := chi.NewRouter()
+	router.Get("/", provider.getWarps)
+	router.Get("/export", provider.exportWarps)
+
+	router.Route("/{id}", func(subrouter chi.Router) {
+		subrouter.Get("/", provider.getWarpById)
+		subrouter.Get("/corresponding", provider.getCorrespondingWarps)
+	})
+	return router
+}
+
+// WarpQueryParameters holds the unvalidated filter, order and pagination parameters accepted by /warps.
+type WarpQueryParameters struct {
+	Name       string
+	PlayerUUID string
+	CompanyID  string
+	Mode       string
+	// Also match warps of the company's predecessors, if set to 'true'
+	IncludeLegacy string
+	AllianceID    string
+	WorldID       string
+	Type          string
+	Region        string
+	Chunk         string
+	AreaID        string
+
+	OrderBy string
+	SortBy  string
+
+	Limit  string
+	Offset string
+
+	// Dimension to convert the coordinates of the returned warps to
+	Coords string
+}
+
+func warpQueryParametersFromRequest(request *http.Request) WarpQueryParameters {
+	query := request.URL.Query()
+
+	return WarpQueryParameters{
+		Name:       query.Get("name"),
+		PlayerUUID: query.Get("player"),
+		CompanyID:  query.Get("company"),
+		Mode:       query.Get("mode"),
+
+		IncludeLegacy: query.Get("include_legacy"),
+		AllianceID:    query.Get("alliance"),
+		WorldID:       query.Get("world"),
+		Type:          query.Get("type"),
+		Region:        query.Get("region"),
+		Chunk:         query.Get("chunk"),
+		AreaID:        query.Get("area"),
+
+		OrderBy: query.Get("order_by"),
+		SortBy:  query.Get("sort_by"),
+
+		Limit:  query.Get("limit"),
+		Offset: query.Get("offset"),
+
+		Coords: query.Get("coords"),
+	}
+}
+
+// buildWarpCondition combines all filter parameters into a single expression.
+// Returns nil if no filters are specified, or an error describing the first invalid parameter.
+func (provider WarpProviderV2) buildWarpCondition(parameters WarpQueryParameters) (BoolExpression, error) {
+	provider = provider.withSnapshot()
+
+	companiesByID := provider.companyProvider().companiesByID
+	companiesByMode := provider.companyProvider().companiesByMode
+	worldsByID := provider.worldProvider().worldsByID
 
 	andExpressions := []BoolExpression{}
 
 	// Filter by name
-	if name != "" {
-		andExpressions = append(andExpressions, table.Warp.Name.EQ(String(name)))
+	if parameters.Name != "" {
+		andExpressions = append(andExpressions, table.Warp.Name.EQ(String(parameters.Name)))
 	}
 
 	// Filter by player
-	if playerUUID != "" {
-		// Add hyphens to the UUID if they are missing
-		if len(playerUUID) == 32 {
-			playerUUID = fmt.Sprintf("%s-%s-%s-%s-%s", playerUUID[0:8], playerUUID[8:12], playerUUID[12:16], playerUUID[16:20], playerUUID[20:32])
+	if parameters.PlayerUUID != "" {
+		playerUUID, valid := normalizePlayerUUID(parameters.PlayerUUID)
+		if !valid {
+			return nil, errors.New("The 'player' query parameter must be a UUID that has 32 hexadecimal digits (with or without hyphens).")
 		}
 
-		if !isValidUUID(playerUUID) {
-			detail := "The 'player' query parameter must be a UUID that has 32 hexadecimal digits (with or without hyphens)."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
+		andExpressions = append(andExpressions, table.Player.UUID.EQ(String(playerUUID)))
+	}
+
+	includeLegacy := false
+	if parameters.IncludeLegacy != "" {
+		if parameters.IncludeLegacy != "true" && parameters.IncludeLegacy != "false" {
+			return nil, errors.New("The 'include_legacy' query parameter must be either 'true' or 'false'.")
 		}
 
-		andExpressions = append(andExpressions, table.Player.UUID.EQ(String(playerUUID)))
+		includeLegacy = parameters.IncludeLegacy == "true"
 	}
 
 	// Filter by company
-	if companyID != "" {
-		company, exists := companiesByID.Get(companyID)
+	if parameters.CompanyID == NO_COMPANY_ID {
+		orExpressions := []BoolExpression{}
+
+		for _, company := range provider.companyProvider().companies {
+			orExpressions = append(orExpressions, company.warpCondition())
+		}
+
+		// Only include warps that do not match any company
+		if len(orExpressions) > 0 {
+			andExpressions = append(andExpressions, NOT(OR(orExpressions...)))
+		}
+	} else if parameters.CompanyID != "" {
+		company, exists := companiesByID.Get(parameters.CompanyID)
 
 		if !exists {
-			detail := "The 'company' query parameter must be one of the IDs returned from the /companies endpoint."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
+			return nil, errors.New("The 'company' query parameter must be 'none', or one of the IDs returned from the /companies endpoint.")
 		}
 
-		andExpressions = append(andExpressions, table.Warp.Name.LIKE(String(company.Pattern)))
+		companyExpression := company.warpCondition()
+
+		if includeLegacy {
+			orExpressions := []BoolExpression{companyExpression}
+
+			for _, legacyCompany := range provider.companyProvider().getLegacyCompanies(company) {
+				orExpressions = append(orExpressions, legacyCompany.warpCondition())
+			}
+
+			companyExpression = OR(orExpressions...)
+		}
+
+		andExpressions = append(andExpressions, companyExpression)
+	}
+
+	// Filter by alliance
+	if parameters.AllianceID != "" {
+		companies, exists := provider.allianceProvider().companiesByAlliance[parameters.AllianceID]
+
+		if !exists {
+			return nil, errors.New("The 'alliance' query parameter must be one of the IDs returned from the /alliances endpoint.")
+		}
+
+		orExpressions := []BoolExpression{}
+
+		for _, company := range companies {
+			orExpressions = append(orExpressions, company.warpCondition())
+
+			if includeLegacy {
+				for _, legacyCompany := range provider.companyProvider().getLegacyCompanies(company) {
+					orExpressions = append(orExpressions, legacyCompany.warpCondition())
+				}
+			}
+		}
+
+		// If the alliance has no companies, set this expression to false so that no results are returned.
+		combinedOrExpression := Bool(true).IS_FALSE()
+		if len(orExpressions) > 0 {
+			combinedOrExpression = OR(orExpressions...)
+		}
+
+		andExpressions = append(andExpressions, combinedOrExpression)
 	}
 
 	// Filter by mode
-	if mode != "" {
-		companies, exists := companiesByMode.Get(TransportMode(mode))
+	if parameters.Mode != "" {
+		companies, exists := companiesByMode.Get(TransportMode(parameters.Mode))
 
 		if !exists {
-			detail := "The 'mode' query parameter must be one of 'warp_rail', 'bus', 'air', 'sea', or 'other'."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
+			return nil, errors.New(provider.modeProvider().invalidModeMessage("mode"))
 		}
 
 		orExpressions := []BoolExpression{}
 
 		for i := range companies {
 			company := companies[i]
-			orExpressions = append(orExpressions, table.Warp.Name.LIKE(String(company.Pattern)))
+			orExpressions = append(orExpressions, company.warpCondition())
 		}
 
 		// If no companies have the specified mode, set this expression to false so that no results are returned.
@@ -145,13 +392,11 @@ func (provider WarpProviderV2) getWarps(writer http.ResponseWriter, request *htt
 	}
 
 	// Filter by world
-	if worldID != "" {
-		world, exists := worldsByID.Get(worldID)
+	if parameters.WorldID != "" {
+		world, exists := worldsByID.Get(parameters.WorldID)
 
 		if !exists {
-			detail := "The 'world' query parameter must be one of the IDs returned from the /worlds endpoint."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
+			return nil, errors.New("The 'world' query parameter must be one of the IDs returned from the /worlds endpoint.")
 		}
 
 		worldUUID := world.UUID
@@ -159,156 +404,219 @@ func (provider WarpProviderV2) getWarps(writer http.ResponseWriter, request *htt
 	}
 
 	// Filter by type
-	if typeStr != "" {
-		typeInt, err := strconv.Atoi(typeStr)
+	if parameters.Type != "" {
+		typeInt, err := strconv.Atoi(parameters.Type)
 		if err != nil || typeInt < 0 || typeInt > 1 {
-			detail := "The 'type' query parameter must be either 0 (private) or 1 (public)."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
+			return nil, errors.New("The 'type' query parameter must be either 0 (private) or 1 (public).")
 		}
 
 		andExpressions = append(andExpressions, table.Warp.Type.EQ(Int(int64(typeInt))))
 	}
 
-	// Combine all filters
-	if len(andExpressions) > 0 {
-		combinedAndExpression := AND(andExpressions...)
+	// Filter by region file
+	if parameters.Region != "" {
+		regionX, regionZ, err := parseRegionParameter(parameters.Region)
+		if err != nil {
+			return nil, err
+		}
 
-		selectStatement.WHERE(combinedAndExpression)
-		countStatement.WHERE(combinedAndExpression)
+		andExpressions = append(andExpressions, buildGridCellCondition(regionX, regionZ, REGION_SIZE))
 	}
 
+	// Filter by chunk
+	if parameters.Chunk != "" {
+		chunkX, chunkZ, err := parseChunkParameter(parameters.Chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		andExpressions = append(andExpressions, buildGridCellCondition(chunkX, chunkZ, CHUNK_SIZE))
+	}
+
+	// Filter by area
+	if parameters.AreaID != "" {
+		area, exists := provider.areaProvider().areasByID.Get(parameters.AreaID)
+
+		if !exists {
+			return nil, errors.New("The 'area' query parameter must be one of the IDs returned from the /areas endpoint.")
+		}
+
+		// Areas are only loaded together with worlds that they are in, and both come from the same snapshot
+		world, _ := worldsByID.Get(area.World)
+		andExpressions = append(andExpressions, area.warpCondition(world))
+	}
+
+	// Combine all filters
+	if len(andExpressions) == 0 {
+		return nil, nil
+	}
+
+	return AND(andExpressions...), nil
+}
+
+func buildWarpOrderByClause(parameters WarpQueryParameters) (OrderByClause, error) {
 	var column Column
-	var orderByClause OrderByClause
 
 	// Order by name, creation date, or visits
-	if orderBy != "" {
-		switch orderBy {
-		case "name":
-			column = table.Warp.Name
-		case "creation_date":
-			column = table.Warp.CreationDate
-		case "visits":
-			column = table.Warp.Visits
-		default:
-			detail := "The 'order_by' query parameter must be one of 'name', 'creation_date', or 'visits'."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
-		}
-	} else {
+	switch parameters.OrderBy {
+	case "":
 		column = table.Warp.WarpID
+	case "name":
+		column = table.Warp.Name
+	case "creation_date":
+		column = table.Warp.CreationDate
+	case "visits":
+		column = table.Warp.Visits
+	default:
+		return nil, errors.New("The 'order_by' query parameter must be one of 'name', 'creation_date', or 'visits'.")
 	}
 
 	// Sort by ascending or descending
-	if sortBy != "" {
-		switch sortBy {
-		case "asc":
-			orderByClause = column.ASC()
-		case "desc":
-			orderByClause = column.DESC()
-		default:
-			detail := "The 'sort_by' query parameter must be one of 'asc' or 'desc'."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
-		}
-	} else {
-		orderByClause = column.ASC()
+	switch parameters.SortBy {
+	case "", "asc":
+		return column.ASC(), nil
+	case "desc":
+		return column.DESC(), nil
+	default:
+		return nil, errors.New("The 'sort_by' query parameter must be one of 'asc' or 'desc'.")
 	}
+}
 
-	selectStatement.ORDER_BY(orderByClause)
-
+func buildWarpPagination(parameters WarpQueryParameters) (int, int, error) {
 	// Limit to a number of records
 	limit := MAX_WARPS_LIMIT
 
 	// Use a different limit if specified
-	if limitStr != "" {
-		new_limit, err := strconv.Atoi(limitStr)
-		if err != nil || new_limit < 0 || new_limit > MAX_WARPS_LIMIT {
-			detail := fmt.Sprintf("The 'limit' query parameter must be an unsigned integer within the following range: 0 <= limit <= %d.", MAX_WARPS_LIMIT)
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
+	if parameters.Limit != "" {
+		newLimit, err := strconv.Atoi(parameters.Limit)
+		if err != nil || newLimit < 0 || newLimit > MAX_WARPS_LIMIT {
+			return 0, 0, fmt.Errorf("The 'limit' query parameter must be an unsigned integer within the following range: 0 <= limit <= %d.", MAX_WARPS_LIMIT)
 		}
 
-		limit = new_limit
+		limit = newLimit
 	}
 
-	selectStatement.LIMIT(int64(limit))
-
 	// Offset number of records
 	offset := 0
 
-	if offsetStr != "" {
-		new_offset, err := strconv.Atoi(offsetStr)
-		if err != nil || offset < 0 {
-			detail := "The 'offset' query parameter must be an unsigned integer."
-			render.Render(writer, request, ErrorBadRequest(detail))
-			return
+	if parameters.Offset != "" {
+		newOffset, err := strconv.Atoi(parameters.Offset)
+		if err != nil || newOffset < 0 {
+			return 0, 0, errors.New("The 'offset' query parameter must be an unsigned integer.")
 		}
 
-		offset = new_offset
+		offset = newOffset
 	}
 
+	return limit, offset, nil
+}
+
+// queryWarps returns a single page of warps matching the condition, along with the total number of matches.
+func (provider WarpProviderV2) queryWarps(ctx context.Context, condition BoolExpression, orderByClause OrderByClause, limit int, offset int) (WarpResponse, error) {
+	provider = provider.withSnapshot()
+	warps := []Warp{}
+
+	selectStatement := beginWarpSelectStatement()
+	countStatement := beginWarpCountStatement()
+
+	if condition != nil {
+		selectStatement.WHERE(condition)
+		countStatement.WHERE(condition)
+	}
+
+	selectStatement.ORDER_BY(orderByClause)
+	selectStatement.LIMIT(int64(limit))
 	selectStatement.OFFSET(int64(offset))
 
-	err := selectStatement.Query(db, &warps)
-	checkForErrors(err)
+	err := selectStatement.QueryContext(ctx, provider.db, &warps)
+	if err != nil {
+		return WarpResponse{}, err
+	}
+
+	for i := range warps {
+		provider.setComputedFields(&warps[i])
+	}
 
 	countResult := CountResult{}
 
-	err = countStatement.Query(db, &countResult)
-	checkForErrors(err)
+	err = countStatement.QueryContext(ctx, provider.db, &countResult)
+	if err != nil {
+		return WarpResponse{}, err
+	}
 
 	hits := len(warps)
-	total_hits := int(countResult.Count)
+	totalHits := int(countResult.Count)
 
-	pagination := WarpResponsePagination{limit, offset, hits, total_hits}
-	response := WarpResponse{pagination, warps}
+	pagination := WarpResponsePagination{limit, offset, hits, totalHits}
+	return WarpResponse{pagination, warps}, nil
+}
 
-	err = render.Render(writer, request, response)
-	// err = render.RenderList(writer, request, toRenderList(warps))
+// streamWarps executes the query without a limit and passes each row to the callback as it is read from the database cursor.
+// Iteration stops at the first error returned by the callback.
+func (provider WarpProviderV2) streamWarps(ctx context.Context, condition BoolExpression, orderByClause OrderByClause, callback func(warp Warp) error) error {
+	provider = provider.withSnapshot()
+	statement := beginWarpSelectStatement()
+
+	if condition != nil {
+		statement.WHERE(condition)
+	}
+
+	statement.ORDER_BY(orderByClause)
+
+	rows, err := statement.Rows(ctx, provider.db)
 	if err != nil {
-		render.Render(writer, request, ErrorRender(err))
-		return
+		return err
 	}
-}
+	defer rows.Close()
 
-// getWarpById  godoc
-// @summary     Get warp by ID
-// @description Get warp by ID.
-// @tags        Warps
-// @produce     json
-// @param       id  path     int   true "Warp ID"
-// @success     200 {object} Warp
-// @failure     400 {object} Error
-// @failure     404 {object} Error
-// @router      /warps/{id} [get]
-func (provider WarpProviderV2) getWarpById(writer http.ResponseWriter, request *http.Request) {
-	warps := []Warp{}
+	for rows.Next() {
+		warp := Warp{}
 
-	idStr := chi.URLParam(request, "id")
-	id, err := strconv.Atoi(idStr)
-	if err != nil || id < 0 {
-		detail := "The 'id' parameter must be an unsigned integer."
-		render.Render(writer, request, ErrorBadRequest(detail))
-		return
+		err = rows.Scan(&warp)
+		if err != nil {
+			return err
+		}
+
+		provider.setComputedFields(&warp)
+
+		err = callback(warp)
+		if err != nil {
+			return err
+		}
 	}
 
+	return rows.Err()
+}
+
+func (provider WarpProviderV2) queryWarpById(ctx context.Context, id uint32) (Warp, bool, error) {
+	provider = provider.withSnapshot()
+	warps := []Warp{}
+
 	statement := beginWarpSelectStatement()
 
-	statement.WHERE(table.Warp.WarpID.EQ(Int(int64(id))))
+	statement.WHERE(table.Warp.WarpID.EQ(Uint32(id)))
 
-	err = statement.Query(provider.db, &warps)
-	checkForErrors(err)
+	err := statement.QueryContext(ctx, provider.db, &warps)
+	if err != nil {
+		return Warp{}, false, err
+	}
 
 	if len(warps) == 0 {
-		render.Render(writer, request, ErrorNotFound)
-		return
+		return Warp{}, false, nil
 	}
 
-	err = render.Render(writer, request, warps[0])
-	if err != nil {
-		render.Render(writer, request, ErrorRender(err))
-		return
+	provider.setComputedFields(&warps[0])
+	return warps[0], true, nil
+}
+
+// setComputedFields sets the fields of the warp that are calculated from its coordinates, including its location on the web map of its world.
+func (provider WarpProviderV2) setComputedFields(warp *Warp) {
+	warp.setChunkAndRegion()
+
+	world, exists := provider.worldProvider().findWorldByUUID(warp.WorldUUID)
+	if exists {
+		warp.MapURL = world.getMapURL(warp.X, warp.Y, warp.Z)
+		warp.Areas = provider.areaProvider().findAreasForWarp(world, *warp)
 	}
 }
 
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+type WaypointColour struct {
+	Red   uint8
+	Green uint8
+	Blue  uint8
+
+	// Index of the equivalent Minecraft chat colour, used by Xaero's Minimap
+	ChatColourIndex int
+}
+
+// Dimension IDs used by JourneyMap and VoxelMap
+var waypointDimensions = map[WorldDimension]string{
+	Overworld: "overworld",
+	Nether:    "the_nether",
+	End:       "the_end",
+}
+
+// The 16 Minecraft chat colours, in order of their index
+var chatColours = [16][3]uint8{
+	{0x00, 0x00, 0x00}, // Black
+	{0x00, 0x00, 0xAA}, // Dark Blue
+	{0x00, 0xAA, 0x00}, // Dark Green
+	{0x00, 0xAA, 0xAA}, // Dark Aqua
+	{0xAA, 0x00, 0x00}, // Dark Red
+	{0xAA, 0x00, 0xAA}, // Dark Purple
+	{0xFF, 0xAA, 0x00}, // Gold
+	{0xAA, 0xAA, 0xAA}, // Gray
+	{0x55, 0x55, 0x55}, // Dark Gray
+	{0x55, 0x55, 0xFF}, // Blue
+	{0x55, 0xFF, 0x55}, // Green
+	{0x55, 0xFF, 0xFF}, // Aqua
+	{0xFF, 0x55, 0x55}, // Red
+	{0xFF, 0x55, 0xFF}, // Light Purple
+	{0xFF, 0xFF, 0x55}, // Yellow
+	{0xFF, 0xFF, 0xFF}, // White
+}
+
+// JourneyMap waypoint, in the format of a single file in journeymap/data/mp/<server>/waypoints
+type JourneyMapWaypoint struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Icon       string   `json:"icon"`
+	X          int      `json:"x"`
+	Y          int      `json:"y"`
+	Z          int      `json:"z"`
+	R          uint8    `json:"r"`
+	G          uint8    `json:"g"`
+	B          uint8    `json:"b"`
+	Enable     bool     `json:"enable"`
+	Type       string   `json:"type"`
+	Origin     string   `json:"origin"`
+	Dimensions []string `json:"dimensions"`
+	Persistent bool     `json:"persistent"`
+}
+
+// getWorldWaypoints godoc
+// @summary     Get minimap waypoints for world
+// @description Get waypoints for warps in a world, for use in client-side minimap mods. Waypoints are coloured by the transport mode of the company that each warp belongs to.
+// @description Xaero's Minimap waypoints are returned as a single text file, to be placed in XaeroWaypoints/<server>/<world>/.
+// @description JourneyMap waypoints are returned as a zip file of JSON files, to be extracted into journeymap/data/mp/<server>/waypoints/.
+// @description VoxelMap waypoints are returned as a single .points file, to be placed in voxelmap/.
+// @tags        Worlds
+// @produce     plain
+// @produce     application/zip
+// @param       id             path     string true  "World ID"
+// @param       format         query    string true  "Waypoint format: 'xaero', 'journeymap', or 'voxelmap'."
+// @param       name           query    string false "Filter by warp name."
+// @param       player         query    string false "Filter by player UUID (can be with or without hyphens)."
+// @param       company        query    string false "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company."
+// @param       include_legacy query    bool   false "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter."
+// @param       alliance       query    string false "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance."
+// @param       mode           query    string false "Filter by transport mode ID (from /modes)."
+// @param       type           query    int    false "Filter by type (0 = private, 1 = public)."
+// @param       region         query    string false "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files."
+// @param       chunk          query    string false "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40')."
+// @param       area           query    string false "Filter by area ID (from /areas), matching the warps that lie within the area."
+// @success     200            {file}   file
+// @failure     400            {object} Error
+// @failure     404            {object} Error
+// @router      /worlds/{id}/waypoints [get]
+func (provider WarpProviderV2) getWorldWaypoints(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+	format := request.URL.Query().Get("format")
+
+	world, exists := provider.worldProvider().worldsByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	if format != "xaero" && format != "journeymap" && format != "voxelmap" {
+		detail := "The 'format' query parameter must be one of 'xaero', 'journeymap', or 'voxelmap'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	parameters := warpQueryParametersFromRequest(request)
+	parameters.WorldID = world.ID
+
+	condition, err := provider.buildWarpCondition(parameters)
+	if err != nil {
+		render.Render(writer, request, ErrorBadRequest(err.Error()))
+		return
+	}
+
+	warps := []Warp{}
+	err = provider.streamWarps(request.Context(), condition, table.Warp.Name.ASC(), func(warp Warp) error {
+		warps = append(warps, warp)
+		return nil
+	})
+	checkForErrors(err)
+
+	switch format {
+	case "xaero":
+		writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		writer.Header().Set("Content-Disposition", `attachment; filename="mw$default_1.txt"`)
+		writer.Write([]byte(provider.buildXaeroWaypoints(warps)))
+	case "journeymap":
+		writer.Header().Set("Content-Type", "application/zip")
+		writer.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="mrt-%s-waypoints.zip"`, world.ID))
+		err = provider.writeJourneyMapWaypoints(writer, world, warps)
+		checkForErrors(err)
+	case "voxelmap":
+		writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		writer.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="mrt-%s.points"`, world.ID))
+		writer.Write([]byte(provider.buildVoxelMapWaypoints(world, warps)))
+	}
+}
+
+// getWaypointColour returns the colour of the warp's transport mode, or of the 'other' mode if the warp does not belong to a company.
+func (provider WarpProviderV2) getWaypointColour(warp Warp) WaypointColour {
+	mode := Other
+
+	company, exists := provider.companyProvider().findCompanyForWarp(warp.Name)
+	if exists {
+		mode = company.Mode
+	}
+
+	return parseWaypointColour(provider.modeProvider().getMode(mode).Colour)
+}
+
+// parseWaypointColour converts a colour in the format "#RRGGBB", which has already been validated, into a waypoint colour.
+func parseWaypointColour(hex string) WaypointColour {
+	colour := WaypointColour{}
+	fmt.Sscanf(hex, "#%02x%02x%02x", &colour.Red, &colour.Green, &colour.Blue)
+
+	// Use the nearest chat colour, since Xaero's Minimap does not support other colours
+	nearestDistance := -1
+	for i, chatColour := range chatColours {
+		distance := 0
+		for j, component := range []uint8{colour.Red, colour.Green, colour.Blue} {
+			difference := int(component) - int(chatColour[j])
+			distance += difference * difference
+		}
+
+		if nearestDistance < 0 || distance < nearestDistance {
+			nearestDistance = distance
+			colour.ChatColourIndex = i
+		}
+	}
+
+	return colour
+}
+
+func (provider WarpProviderV2) buildXaeroWaypoints(warps []Warp) string {
+	var builder strings.Builder
+	builder.WriteString("#\n")
+	builder.WriteString("#waypoint:name:initials:x:y:z:color:disabled:type:set:rotate_on_tp:tp_yaw:visibility_type:destination\n")
+	builder.WriteString("#\n")
+
+	for _, warp := range warps {
+		// Xaero's Minimap uses colons as separators, and represents colons within values as "§§"
+		name := strings.ReplaceAll(warp.Name, ":", "§§")
+		initials := strings.ReplaceAll(getWaypointInitials(warp.Name), ":", "§§")
+		colour := provider.getWaypointColour(warp)
+		x, y, z := warp.blockCoordinates()
+
+		builder.WriteString(fmt.Sprintf("waypoint:%s:%s:%d:%d:%d:%d:false:0:gui.xaero_default:false:0:0:false\n",
+			name, initials, x, y, z, colour.ChatColourIndex))
+	}
+
+	return builder.String()
+}
+
+// getWaypointInitials returns the first character of the warp name, which Xaero's Minimap shows on the waypoint.
+// Warp names should never be empty, but a placeholder is used just in case, since the initials are required.
+func getWaypointInitials(name string) string {
+	runes := []rune(name)
+	if len(runes) == 0 {
+		return "?"
+	}
+
+	return string(runes[:1])
+}
+
+func (provider WarpProviderV2) writeJourneyMapWaypoints(writer http.ResponseWriter, world World, warps []Warp) error {
+	zipWriter := zip.NewWriter(writer)
+
+	for _, warp := range warps {
+		colour := provider.getWaypointColour(warp)
+		x, y, z := warp.blockCoordinates()
+		id := fmt.Sprintf("%s_%d,%d,%d", warp.Name, x, y, z)
+
+		waypoint := JourneyMapWaypoint{
+			ID:         id,
+			Name:       warp.Name,
+			Icon:       "waypoint-normal.png",
+			X:          x,
+			Y:          y,
+			Z:          z,
+			R:          colour.Red,
+			G:          colour.Green,
+			B:          colour.Blue,
+			Enable:     true,
+			Type:       "Normal",
+			Origin:     "JourneyMap",
+			Dimensions: []string{"minecraft:" + waypointDimensions[world.Dimension]},
+			Persistent: true,
+		}
+
+		fileWriter, err := zipWriter.Create(fmt.Sprintf("warp_%d.json", warp.ID))
+		if err != nil {
+			return err
+		}
+
+		err = json.NewEncoder(fileWriter).Encode(waypoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	return zipWriter.Close()
+}
+
+func (provider WarpProviderV2) buildVoxelMapWaypoints(world World, warps []Warp) string {
+	// VoxelMap uses commas and colons as separators, and represents them within values with look-alike characters
+	replacer := strings.NewReplacer(",", "﹐", ":", "˸")
+
+	var builder strings.Builder
+	for _, warp := range warps {
+		colour := provider.getWaypointColour(warp)
+		x, y, z := warp.blockCoordinates()
+
+		builder.WriteString(fmt.Sprintf("name:%s,x:%d,z:%d,y:%d,enabled:true,red:%.3f,green:%.3f,blue:%.3f,suffix:,world:,dimensions:%s#\n",
+			replacer.Replace(warp.Name), x, z, y,
+			float64(colour.Red)/255, float64(colour.Green)/255, float64(colour.Blue)/255, waypointDimensions[world.Dimension]))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+// Number of overworld blocks that correspond to a single nether block, horizontally
+const NETHER_SCALE = 8
+
+// Radius (in blocks of the paired world) searched around the corresponding location
+const DEFAULT_CORRESPONDING_RADIUS = 128
+const MAX_CORRESPONDING_RADIUS = 1024
+
+const DEFAULT_CORRESPONDING_LIMIT = 10
+const MAX_CORRESPONDING_LIMIT = 100
+
+type WarpWithDistance struct {
+	Warp
+
+	// Horizontal distance (in blocks) from the corresponding location
+	Distance float64 `json:"distance"`
+}
+
+type CorrespondingLocation struct {
+	// The warp that the location corresponds to
+	Warp Warp `json:"warp"`
+
+	// ID of the paired world, and the position in it that corresponds to the warp
+	World string  `json:"world"`
+	X     float64 `json:"x"`
+	Z     float64 `json:"z"`
+
+	// Warps in the paired world that are within the radius of the position, ordered by distance (nearest first)
+	Warps []WarpWithDistance `json:"warps"`
+}
+
+func (location CorrespondingLocation) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// getCorrespondingWarps godoc
+// @summary     Get corresponding location of warp
+// @description Get the location in the paired world (from the 'pair' of the warp's world in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml) that corresponds to the warp, and the nearest warps to that location.
+// @description Overworld coordinates are divided by 8 to get nether coordinates, and nether coordinates are multiplied by 8 to get overworld coordinates. Only the horizontal (x, z) position is used.
+// @tags        Warps
+// @produce     json
+// @param       id     path     int    true  "Warp ID"
+// @param       radius query    number false "Maximum distance (in blocks of the paired world) of warps from the corresponding location. Default is 128, maximum is 1024."
+// @param       limit  query    int    false "Limit number of warps returned. Default is 10, maximum is 100."
+// @success     200    {object} CorrespondingLocation
+// @failure     400    {object} Error
+// @failure     404    {object} Error
+// @router      /warps/{id}/corresponding [get]
+func (provider WarpProviderV2) getCorrespondingWarps(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	idStr := chi.URLParam(request, "id")
+	radiusStr := request.URL.Query().Get("radius")
+	limitStr := request.URL.Query().Get("limit")
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		detail := "The 'id' parameter must be an unsigned integer."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	radius := float64(DEFAULT_CORRESPONDING_RADIUS)
+
+	if radiusStr != "" {
+		newRadius, err := strconv.ParseFloat(radiusStr, 64)
+		if err != nil || newRadius < 0 || newRadius > MAX_CORRESPONDING_RADIUS {
+			detail := fmt.Sprintf("The 'radius' query parameter must be a number within the following range: 0 <= radius <= %d.", MAX_CORRESPONDING_RADIUS)
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		radius = newRadius
+	}
+
+	limit := DEFAULT_CORRESPONDING_LIMIT
+
+	if limitStr != "" {
+		newLimit, err := strconv.Atoi(limitStr)
+		if err != nil || newLimit < 1 || newLimit > MAX_CORRESPONDING_LIMIT {
+			detail := fmt.Sprintf("The 'limit' query parameter must be an integer within the following range: 1 <= limit <= %d.", MAX_CORRESPONDING_LIMIT)
+			render.Render(writer, request, ErrorBadRequest(detail))
+			return
+		}
+
+		limit = newLimit
+	}
+
+	warp, exists, err := provider.queryWarpById(request.Context(), uint32(id))
+	checkForErrors(err)
+
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	world, exists := provider.worldProvider().findWorldByUUID(warp.WorldUUID)
+	if !exists || world.Pair == "" {
+		detail := "The warp is not in a world that has a paired world in the other dimension."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	pairedWorld, _ := provider.worldProvider().worldsByID.Get(world.Pair)
+
+	x, z := convertCoordinates(warp.X, warp.Z, world.Dimension, pairedWorld.Dimension)
+	nearbyWarps, err := provider.queryNearbyWarps(request.Context(), pairedWorld, Point{x, z}, radius, limit)
+	checkForErrors(err)
+
+	location := CorrespondingLocation{
+		Warp:  warp,
+		World: pairedWorld.ID,
+		X:     x,
+		Z:     z,
+		Warps: nearbyWarps,
+	}
+
+	err = render.Render(writer, request, location)
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// queryNearbyWarps returns the warps in the world that are within the radius of the point, ordered by distance (nearest first).
+func (provider WarpProviderV2) queryNearbyWarps(ctx context.Context, world World, point Point, radius float64, limit int) ([]WarpWithDistance, error) {
+	// Narrow down the warps to a square around the point in the database, then check the actual distance of each
+	condition := table.World.UUID.EQ(String(world.UUID)).
+		AND(table.Warp.X.BETWEEN(Float(point.X-radius), Float(point.X+radius))).
+		AND(table.Warp.Z.BETWEEN(Float(point.Z-radius), Float(point.Z+radius)))
+
+	nearbyWarps := []WarpWithDistance{}
+
+	err := provider.streamWarps(ctx, condition, table.Warp.WarpID.ASC(), func(warp Warp) error {
+		distance := point.distance(Point{warp.X, warp.Z})
+		if distance <= radius {
+			nearbyWarps = append(nearbyWarps, WarpWithDistance{warp, distance})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(nearbyWarps, func(i, j int) bool {
+		return nearbyWarps[i].Distance < nearbyWarps[j].Distance
+	})
+
+	if len(nearbyWarps) > limit {
+		nearbyWarps = nearbyWarps[:limit]
+	}
+
+	return nearbyWarps, nil
+}
+
+// convertCoordinates converts the horizontal position from one dimension to another.
+// Positions in the end, or converted to or from the end, are unchanged.
+func convertCoordinates(x float64, z float64, from WorldDimension, to WorldDimension) (float64, float64) {
+	switch {
+	case from == Overworld && to == Nether:
+		return x / NETHER_SCALE, z / NETHER_SCALE
+	case from == Nether && to == Overworld:
+		return x * NETHER_SCALE, z * NETHER_SCALE
+	default:
+		return x, z
+	}
+}
+
+// parseCoordsParameter returns the dimension that warp coordinates should be converted to, or an empty dimension if they should not be converted.
+func parseCoordsParameter(value string) (WorldDimension, error) {
+	switch WorldDimension(value) {
+	case "":
+		return "", nil
+	case Overworld, Nether:
+		return WorldDimension(value), nil
+	default:
+		return "", errors.New("The 'coords' query parameter must be one of 'overworld' or 'nether'.")
+	}
+}
+
+// convertWarpCoordinates converts the position of the warp to the dimension, based on the dimension of the warp's world.
+// Warps in unregistered worlds are unchanged. The map URL keeps pointing to the original position, since it is on the map of the warp's world.
+func (provider WarpProviderV2) convertWarpCoordinates(warp *Warp, dimension WorldDimension) {
+	if dimension == "" {
+		return
+	}
+
+	world, exists := provider.worldProvider().findWorldByUUID(warp.WorldUUID)
+	if exists {
+		warp.X, warp.Z = convertCoordinates(warp.X, warp.Z, world.Dimension, dimension)
+	}
+}
+
+// pairWorlds checks that each pair of worlds is an overworld and a nether that do not disagree about their pairs, and fills in the pair of the other world if only one of them specifies it.
+func pairWorlds(worlds []World) error {
+	indicesByID := map[string]int{}
+	for i, world := range worlds {
+		indicesByID[world.ID] = i
+	}
+
+	for i := range worlds {
+		world := &worlds[i]
+		if world.Pair == "" {
+			continue
+		}
+
+		j, exists := indicesByID[world.Pair]
+		if !exists {
+			return fmt.Errorf("The world '%s' has an invalid pair: '%s'", world.ID, world.Pair)
+		}
+
+		pairedWorld := &worlds[j]
+
+		if !(world.Dimension == Overworld && pairedWorld.Dimension == Nether) && !(world.Dimension == Nether && pairedWorld.Dimension == Overworld) {
+			return fmt.Errorf("The world '%s' is paired with '%s', but a pair must be an overworld and a nether", world.ID, pairedWorld.ID)
+		}
+
+		if pairedWorld.Pair != "" && pairedWorld.Pair != world.ID {
+			return fmt.Errorf("The world '%s' is paired with '%s', but '%s' is paired with '%s'", world.ID, pairedWorld.ID, pairedWorld.ID, pairedWorld.Pair)
+		}
+
+		pairedWorld.Pair = world.ID
+	}
+
+	return nil
+}
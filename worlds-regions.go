@@ -0,0 +1,153 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+// Width of a chunk and of a region file (32 x 32 chunks), in blocks
+const CHUNK_SIZE = 16
+const REGION_SIZE = 512
+
+type WorldRegion struct {
+	// Name of the region file, e.g. 'r.-1.2.mca'
+	Name      string `json:"name"`
+	RegionX   int    `json:"regionX"`
+	RegionZ   int    `json:"regionZ"`
+	WarpCount int    `json:"warpCount"`
+}
+
+func (region WorldRegion) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+// getWorldRegions godoc
+// @summary     List regions with warps in world
+// @description List the region files of a world that contain at least one warp, with the number of warps in each. Regions are ordered by x, then z.
+// @description Region files that are not listed do not contain any warps.
+// @tags        Worlds
+// @produce     json
+// @param       id  path     string true "World ID"
+// @success     200 {array}  WorldRegion
+// @failure     404 {object} Error
+// @router      /worlds/{id}/regions [get]
+func (provider WarpProviderV2) getWorldRegions(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+
+	world, exists := provider.worldProvider().worldsByID.Get(id)
+	if !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	warpCounts := map[[2]int]int{}
+
+	condition := table.World.UUID.EQ(String(world.UUID))
+	err := provider.streamWarps(request.Context(), condition, table.Warp.WarpID.ASC(), func(warp Warp) error {
+		warpCounts[[2]int{warp.RegionX, warp.RegionZ}]++
+		return nil
+	})
+	checkForErrors(err)
+
+	regions := []WorldRegion{}
+	for region, count := range warpCounts {
+		regions = append(regions, WorldRegion{
+			Name:      fmt.Sprintf("r.%d.%d.mca", region[0], region[1]),
+			RegionX:   region[0],
+			RegionZ:   region[1],
+			WarpCount: count,
+		})
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		if regions[i].RegionX != regions[j].RegionX {
+			return regions[i].RegionX < regions[j].RegionX
+		}
+		return regions[i].RegionZ < regions[j].RegionZ
+	})
+
+	err = render.RenderList(writer, request, toRenderList(regions))
+	if err != nil {
+		render.Render(writer, request, ErrorRender(err))
+		return
+	}
+}
+
+// setChunkAndRegion sets the chunk and region file that contain the warp, from its block coordinates.
+func (warp *Warp) setChunkAndRegion() {
+	warp.WarpGridLocation = &WarpGridLocation{
+		ChunkX:  int(math.Floor(warp.X / CHUNK_SIZE)),
+		ChunkZ:  int(math.Floor(warp.Z / CHUNK_SIZE)),
+		RegionX: int(math.Floor(warp.X / REGION_SIZE)),
+		RegionZ: int(math.Floor(warp.Z / REGION_SIZE)),
+	}
+}
+
+// buildGridCellCondition matches warps within the square area that starts at the given cell of a grid, where each cell is the size (in blocks).
+func buildGridCellCondition(cellX int, cellZ int, size int) BoolExpression {
+	minX := float64(cellX * size)
+	minZ := float64(cellZ * size)
+
+	return table.Warp.X.GT_EQ(Float(minX)).
+		AND(table.Warp.X.LT(Float(minX + float64(size)))).
+		AND(table.Warp.Z.GT_EQ(Float(minZ))).
+		AND(table.Warp.Z.LT(Float(minZ + float64(size))))
+}
+
+// parseRegionParameter parses a region file name in the format 'r.x.z', with or without the '.mca' extension.
+func parseRegionParameter(value string) (int, int, error) {
+	invalid := errors.New("The 'region' query parameter must be a region file name in the format 'r.x.z' (e.g. 'r.-1.2').")
+
+	parts := strings.Split(strings.TrimSuffix(value, ".mca"), ".")
+	if len(parts) != 3 || parts[0] != "r" {
+		return 0, 0, invalid
+	}
+
+	x, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, invalid
+	}
+
+	z, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, 0, invalid
+	}
+
+	return x, z, nil
+}
+
+// parseChunkParameter parses chunk coordinates in the format 'x,z'.
+func parseChunkParameter(value string) (int, int, error) {
+	invalid := errors.New("The 'chunk' query parameter must be chunk coordinates in the format 'x,z' (e.g. '-12,40').")
+
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return 0, 0, invalid
+	}
+
+	x, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, invalid
+	}
+
+	z, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, invalid
+	}
+
+	return x, z, nil
+}
@@ -1,16 +1,76 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"math"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/frumple/mrt-api/gen/mywarp_main/table"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	orderedmap "github.com/wk8/go-ordered-map/v2"
+
+	//lint:ignore ST1001 This dot import is intended for Jet SQL statements (SELECT, FROM, etc.)
+	. "github.com/go-jet/jet/v2/mysql"
+)
+
+type WorldDimension string
+
+const (
+	Overworld WorldDimension = "overworld"
+	Nether    WorldDimension = "nether"
+	End       WorldDimension = "end"
 )
 
 type World struct {
 	ID   string `json:"id"`
 	UUID string `json:"uuid"`
+
+	// Display name of the world, which is the same as the ID if not specified
+	Name string `json:"name"`
+
+	// Dimension of the world, which is the overworld if not specified
+	Dimension WorldDimension `json:"dimension"`
+
+	// Optional metadata
+	Spawn  *WorldPoint  `json:"spawn,omitempty"`
+	Border *WorldBorder `json:"border,omitempty"`
+
+	// Template of a URL that shows a location on the world's web map, with the placeholders {x}, {y} and {z}
+	MapURL string `json:"mapUrl,omitempty" yaml:"map_url"`
+
+	// ID of the world in the other dimension that is linked to this world by nether portals (an overworld and a nether)
+	Pair string `json:"pair,omitempty"`
+}
+
+type WorldWithWarpCount struct {
+	World
+
+	// False if the world is in the MyWarp database but not defined in worlds.yml, in which case only its UUID is known
+	Registered bool `json:"registered"`
+	WarpCount  int  `json:"warpCount"`
+}
+
+func (world WorldWithWarpCount) Render(writer http.ResponseWriter, request *http.Request) error {
+	return nil
+}
+
+type WorldPoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// Square world border, in the same format as Minecraft's /worldborder command
+type WorldBorder struct {
+	CenterX float64 `json:"centerX" yaml:"center_x"`
+	CenterZ float64 `json:"centerZ" yaml:"center_z"`
+	// Length of each side of the border, in blocks
+	Size float64 `json:"size"`
 }
 
 func (world World) GetID() string {
@@ -28,59 +88,281 @@ type WorldProvider struct {
 
 // getWorlds    godoc
 // @summary     List all worlds
-// @description List all worlds (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml).
+// @description List all worlds (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml), with the number of warps in each world.
+// @description Set 'include_unregistered' to 'true' to also list worlds in the MyWarp database that are not defined in worlds.yml. These only have a UUID and number of warps, and are listed last.
 // @tags        Worlds
 // @produce     json
-// @success     200 {array} World
+// @param       include_unregistered query    bool false "If 'true', also include worlds that are not defined in worlds.yml."
+// @success     200                  {array}  WorldWithWarpCount
+// @failure     400                  {object} Error
 // @router      /worlds [get]
-func (provider WorldProvider) getWorlds(writer http.ResponseWriter, request *http.Request) {
-	err := render.RenderList(writer, request, toRenderList(provider.worlds))
+func (provider WarpProviderV2) getWorlds(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	includeUnregisteredStr := request.URL.Query().Get("include_unregistered")
+
+	if includeUnregisteredStr != "" && includeUnregisteredStr != "true" && includeUnregisteredStr != "false" {
+		detail := "The 'include_unregistered' query parameter must be either 'true' or 'false'."
+		render.Render(writer, request, ErrorBadRequest(detail))
+		return
+	}
+
+	worlds, err := provider.listWorldsWithWarpCounts(request.Context(), includeUnregisteredStr == "true")
+	checkForErrors(err)
+
+	err = render.RenderList(writer, request, toRenderList(worlds))
 	if err != nil {
 		render.Render(writer, request, ErrorRender(err))
 		return
 	}
 }
 
+// listWorldsWithWarpCounts returns the worlds in worlds.yml with their number of warps, followed by any unregistered worlds if requested.
+func (provider WarpProviderV2) listWorldsWithWarpCounts(ctx context.Context, includeUnregistered bool) ([]WorldWithWarpCount, error) {
+	warpCounts, err := provider.queryWorldWarpCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	worlds := []WorldWithWarpCount{}
+	registeredUUIDs := map[string]bool{}
+
+	for _, world := range provider.worldProvider().worlds {
+		worlds = append(worlds, WorldWithWarpCount{
+			World:      world,
+			Registered: true,
+			WarpCount:  warpCounts[world.UUID],
+		})
+		registeredUUIDs[world.UUID] = true
+	}
+
+	if includeUnregistered {
+		unregisteredWorlds := []WorldWithWarpCount{}
+		for uuid, count := range warpCounts {
+			if !registeredUUIDs[uuid] {
+				unregisteredWorlds = append(unregisteredWorlds, WorldWithWarpCount{
+					World:     World{UUID: uuid},
+					WarpCount: count,
+				})
+			}
+		}
+
+		// Worlds with the most warps are the most important to register
+		sort.Slice(unregisteredWorlds, func(i, j int) bool {
+			if unregisteredWorlds[i].WarpCount != unregisteredWorlds[j].WarpCount {
+				return unregisteredWorlds[i].WarpCount > unregisteredWorlds[j].WarpCount
+			}
+			return unregisteredWorlds[i].UUID < unregisteredWorlds[j].UUID
+		})
+
+		worlds = append(worlds, unregisteredWorlds...)
+	}
+
+	return worlds, nil
+}
+
 // getWorldById godoc
 // @summary     Get world by ID
-// @description Get world by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml).
+// @description Get world by ID (defined in https://github.com/Frumple/mrt-api/blob/main/data/worlds.yml), with the number of warps in the world.
 // @tags        Worlds
 // @produce     json
 // @param       id  path     string true "World ID"
-// @success     200 {object} World
+// @success     200 {object} WorldWithWarpCount
 // @failure     404 {object} Error
 // @router      /worlds/{id} [get]
-func (provider WorldProvider) getWorldById(writer http.ResponseWriter, request *http.Request) {
+func (provider WarpProviderV2) getWorldById(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
 	id := chi.URLParam(request, "id")
 
-	company, exists := provider.worldsByID.Get(id)
+	world, exists := provider.worldProvider().worldsByID.Get(id)
 	if !exists {
 		render.Render(writer, request, ErrorNotFound)
 		return
 	}
 
-	err := render.Render(writer, request, company)
+	warpCounts, err := provider.queryWorldWarpCounts(request.Context())
+	checkForErrors(err)
+
+	worldWithWarpCount := WorldWithWarpCount{
+		World:      world,
+		Registered: true,
+		WarpCount:  warpCounts[world.UUID],
+	}
+
+	err = render.Render(writer, request, worldWithWarpCount)
 	if err != nil {
 		render.Render(writer, request, ErrorRender(err))
 		return
 	}
 }
 
-func worldsRouter(provider WorldProvider) http.Handler {
+// getWorldWarps godoc
+// @summary     List warps in world
+// @description List the warps in a world, with the same filter, order and pagination parameters as /warps. Maximum number of warps returned per request is 2000.
+// @tags        Worlds
+// @produce     json
+// @produce     application/geo+json
+// @param       id             path     string true  "World ID"
+// @param       format         query    string false "Response format: 'json' (default) or 'geojson'."
+// @param       name           query    string false "Filter by warp name."
+// @param       player         query    string false "Filter by player UUID (can be with or without hyphens)."
+// @param       company        query    string false "Filter by company ID (from /companies), or 'none' for warps that do not belong to any company."
+// @param       include_legacy query    bool   false "If 'true', also include warps of the company's predecessors (legacy companies). Only used with the 'company' or 'alliance' filter."
+// @param       alliance       query    string false "Filter by alliance ID (from /alliances), matching the warps of every company in the alliance."
+// @param       mode           query    string false "Filter by transport mode ID (from /modes)."
+// @param       type           query    int    false "Filter by type (0 = private, 1 = public)."
+// @param       region         query    string false "Filter by region file, in the format 'r.x.z' (e.g. 'r.-1.2'). Combine this with the 'world' filter, since every world has its own region files."
+// @param       chunk          query    string false "Filter by chunk coordinates, in the format 'x,z' (e.g. '-12,40')."
+// @param       area           query    string false "Filter by area ID (from /areas), matching the warps that lie within the area."
+// @param       order_by       query    string false "Order by 'name', 'creation_date', or 'visits'."
+// @param       sort_by        query    string false "Sort by 'asc' (ascending) or 'desc' (descending)."
+// @param       limit          query    int    false "Limit number of warps returned. Maximum limit is 2000."
+// @param       offset         query    int    false "Number of warps to skip before returning."
+// @param       coords         query    string false "Convert coordinates to 'overworld' or 'nether' coordinates, based on the dimension of each warp's world (x and z are multiplied or divided by 8)."
+// @success     200            {object} WarpResponse
+// @failure     400            {object} Error
+// @failure     404            {object} Error
+// @router      /worlds/{id}/warps [get]
+func (provider WarpProviderV2) getWorldWarps(writer http.ResponseWriter, request *http.Request) {
+	provider = provider.withSnapshot()
+
+	id := chi.URLParam(request, "id")
+
+	if _, exists := provider.worldProvider().worldsByID.Get(id); !exists {
+		render.Render(writer, request, ErrorNotFound)
+		return
+	}
+
+	parameters := warpQueryParametersFromRequest(request)
+	parameters.WorldID = id
+
+	provider.renderWarps(writer, request, parameters)
+}
+
+type WorldWarpCount struct {
+	UUID  string
+	Count uint32
+}
+
+// queryWorldWarpCounts returns the number of warps in every world in the MyWarp database (including worlds without any warps), by world UUID.
+func (provider WarpProviderV2) queryWorldWarpCounts(ctx context.Context) (map[string]int, error) {
+	rows := []WorldWarpCount{}
+
+	statement := SELECT(
+		table.World.UUID.AS("world_warp_count.uuid"),
+		COUNT(table.Warp.WarpID).AS("world_warp_count.count"),
+	).FROM(
+		table.World.
+			LEFT_JOIN(table.Warp, table.Warp.WorldID.EQ(table.World.WorldID)),
+	).GROUP_BY(
+		table.World.UUID,
+	)
+
+	err := statement.QueryContext(ctx, provider.db, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	warpCounts := map[string]int{}
+	for _, row := range rows {
+		warpCounts[row.UUID] = int(row.Count)
+	}
+
+	return warpCounts, nil
+}
+
+func (provider WorldProvider) findWorldByUUID(uuid string) (World, bool) {
+	for _, world := range provider.worlds {
+		if world.UUID == uuid {
+			return world, true
+		}
+	}
+
+	return World{}, false
+}
+
+// getMapURL returns the URL of the location on the world's web map, or an empty string if the world does not have a map URL template.
+func (world World) getMapURL(x float64, y float64, z float64) string {
+	if world.MapURL == "" {
+		return ""
+	}
+
+	replacer := strings.NewReplacer(
+		"{x}", strconv.Itoa(int(math.Floor(x))),
+		"{y}", strconv.Itoa(int(math.Floor(y))),
+		"{z}", strconv.Itoa(int(math.Floor(z))),
+	)
+
+	return replacer.Replace(world.MapURL)
+}
+
+func worldsRouter(warpProvider WarpProviderV2) http.Handler {
 	router := chi.NewRouter()
-	router.Get("/", provider.getWorlds)
+	router.Get("/", warpProvider.getWorlds)
 
 	router.Route("/{id}", func(subrouter chi.Router) {
-		subrouter.Get("/", provider.getWorldById)
+		subrouter.Get("/", warpProvider.getWorldById)
+		subrouter.Get("/warps", warpProvider.getWorldWarps)
+		subrouter.Get("/regions", warpProvider.getWorldRegions)
+		subrouter.Get("/markers", warpProvider.getWorldMarkers)
+		subrouter.Get("/waypoints", warpProvider.getWorldWaypoints)
 	})
 	return router
 }
 
-func loadWorlds() WorldProvider {
-	worlds := loadStaticData[World](WORLDS_PATH)
+func readWorlds() (WorldProvider, error) {
+	worlds, err := readStaticData[World](WORLDS_PATH)
+	if err != nil {
+		return WorldProvider{}, err
+	}
+
+	for i := range worlds {
+		err = validateWorld(&worlds[i])
+		if err != nil {
+			return WorldProvider{}, err
+		}
+	}
+
+	err = pairWorlds(worlds)
+	if err != nil {
+		return WorldProvider{}, err
+	}
+
 	worldsByID := staticDataToOrderedMap(worlds)
 	return WorldProvider{
 		worlds:     worlds,
 		worldsByID: worldsByID,
+	}, nil
+}
+
+// validateWorld checks the metadata of the world, and fills in the default name and dimension.
+func validateWorld(world *World) error {
+	if world.Name == "" {
+		world.Name = world.ID
+	}
+
+	switch world.Dimension {
+	case "":
+		world.Dimension = Overworld
+	case Overworld, Nether, End:
+	default:
+		return fmt.Errorf("The world '%s' has an invalid dimension (must be 'overworld', 'nether' or 'end'): '%s'", world.ID, world.Dimension)
+	}
+
+	if world.Border != nil && world.Border.Size <= 0 {
+		return fmt.Errorf("The world '%s' has a border with a size that is not positive", world.ID)
 	}
+
+	if world.MapURL != "" {
+		if !strings.Contains(world.MapURL, "{x}") || !strings.Contains(world.MapURL, "{z}") {
+			return fmt.Errorf("The world '%s' has a map URL without the {x} and {z} placeholders: '%s'", world.ID, world.MapURL)
+		}
+
+		if !isValidWebsite(world.getMapURL(0, 0, 0)) {
+			return fmt.Errorf("The world '%s' has an invalid map URL: '%s'", world.ID, world.MapURL)
+		}
+	}
+
+	return nil
 }